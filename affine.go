@@ -0,0 +1,189 @@
+package interval
+
+import (
+	"math"
+	"time"
+)
+
+// Affine moves values of T by offsets of D.
+type Affine[T, D any] interface {
+	// Add returns v moved forward by d.
+	Add(v T, d D) T
+	// Sub returns v moved backward by d.
+	Sub(v T, d D) T
+}
+
+// Scaler scales the offsets of values of T from a pivot.
+type Scaler[T any] interface {
+	// Scale returns the value whose offset from pivot is factor times that of v.
+	Scale(v, pivot T, factor float64) T
+}
+
+var (
+	_ Affine[Int, int]            = IntMetric{}
+	_ Scaler[Int]                 = IntMetric{}
+	_ Affine[Time, time.Duration] = TimeMetric{}
+	_ Scaler[Time]                = TimeMetric{}
+	_ Affine[Time, Period]        = TimeCalendar{}
+)
+
+// Add returns v+d, saturated to the range of Int.
+func (IntMetric) Add(v Int, d int) Int {
+	if d > 0 && int(v) > math.MaxInt-d {
+		return math.MaxInt
+	}
+	if d < 0 && int(v) < math.MinInt-d {
+		return math.MinInt
+	}
+	return v + Int(d)
+}
+
+// Sub returns v-d, saturated to the range of Int.
+func (m IntMetric) Sub(v Int, d int) Int {
+	if d == math.MinInt {
+		// -d overflows, so move by one step less first
+		return m.Add(m.Add(v, math.MaxInt), 1)
+	}
+	return m.Add(v, -d)
+}
+
+// Scale returns the value whose offset from pivot is factor times that of v,
+// rounded to the nearest Int and saturated to the range of Int.
+func (IntMetric) Scale(v, pivot Int, factor float64) Int {
+	f := math.Round(float64(pivot) + (float64(v)-float64(pivot))*factor)
+	switch {
+	case f >= math.MaxInt:
+		return math.MaxInt
+	case f <= math.MinInt:
+		return math.MinInt
+	}
+	return Int(f)
+}
+
+// Add returns v.Add(d).
+func (TimeMetric) Add(v Time, d time.Duration) Time {
+	return Time(time.Time(v).Add(d))
+}
+
+// Sub returns v.Add(-d).
+func (TimeMetric) Sub(v Time, d time.Duration) Time {
+	return Time(time.Time(v).Add(-d))
+}
+
+// Scale returns the time whose offset from pivot is factor times that of v.
+// Offsets are saturated to the range of time.Duration.
+func (TimeMetric) Scale(v, pivot Time, factor float64) Time {
+	f := float64(time.Time(v).Sub(time.Time(pivot))) * factor
+	d := time.Duration(math.MaxInt64)
+	switch {
+	case f <= math.MinInt64:
+		d = math.MinInt64
+	case f < math.MaxInt64:
+		d = time.Duration(f)
+	}
+	return Time(time.Time(pivot).Add(d))
+}
+
+// TimeCalendar moves Time values by calendar periods.
+type TimeCalendar struct{}
+
+// Add returns d.AddTo(v).
+func (TimeCalendar) Add(v Time, d Period) Time {
+	return Time(d.AddTo(time.Time(v)))
+}
+
+// Sub returns d.SubFrom(v).
+func (TimeCalendar) Sub(v Time, d Period) Time {
+	return Time(d.SubFrom(time.Time(v)))
+}
+
+// Shift returns interval with both endpoints moved forward by d.
+// Closedness and unbounded endpoints are kept, and empty intervals stay as they are.
+//
+// Endpoints are clamped wherever a saturates, so the result may be shorter than interval:
+// with IntMetric, shifting [MaxInt-1, MaxInt] by 5 gives [MaxInt, MaxInt],
+// and if the clamped endpoints meet leaving no value, the zero value (an empty interval) is returned.
+func Shift[T Ordered[T], D any](i Interval[T], d D, a Affine[T, D]) Interval[T] {
+	if i.IsEmpty() {
+		return i
+	}
+	if i.Lower.Bounded() {
+		i.Lower.Value = a.Add(i.Lower.Value, d)
+	}
+	if i.Upper.Bounded() {
+		i.Upper.Value = a.Add(i.Upper.Value, d)
+	}
+	if i.IsEmpty() {
+		// saturated endpoints may meet
+		return Interval[T]{}
+	}
+	return i
+}
+
+// Expand returns interval with the lower endpoint moved backward by lower
+// and the upper endpoint moved forward by upper.
+// Closedness and unbounded endpoints are kept, and empty intervals stay as they are.
+// If the endpoints cross over by negative offsets, the zero value (an empty interval) is returned.
+// Endpoints are clamped wherever a saturates, as in Shift.
+func Expand[T Ordered[T], D any](i Interval[T], lower, upper D, a Affine[T, D]) Interval[T] {
+	if i.IsEmpty() {
+		return i
+	}
+	if i.Lower.Bounded() {
+		i.Lower.Value = a.Sub(i.Lower.Value, lower)
+	}
+	if i.Upper.Bounded() {
+		i.Upper.Value = a.Add(i.Upper.Value, upper)
+	}
+	if i.IsEmpty() {
+		return Interval[T]{}
+	}
+	return i
+}
+
+// Shrink returns interval with the lower endpoint moved forward by lower
+// and the upper endpoint moved backward by upper.
+// Closedness and unbounded endpoints are kept.
+// If the endpoints cross over, the zero value (an empty interval) is returned.
+// Endpoints are clamped wherever a saturates, as in Shift.
+func Shrink[T Ordered[T], D any](i Interval[T], lower, upper D, a Affine[T, D]) Interval[T] {
+	if i.IsEmpty() {
+		return i
+	}
+	if i.Lower.Bounded() {
+		i.Lower.Value = a.Add(i.Lower.Value, lower)
+	}
+	if i.Upper.Bounded() {
+		i.Upper.Value = a.Sub(i.Upper.Value, upper)
+	}
+	if i.IsEmpty() {
+		return Interval[T]{}
+	}
+	return i
+}
+
+// Scale returns interval with the offsets of the endpoints from pivot multiplied by factor.
+// Closedness and unbounded endpoints are kept. A negative factor also mirrors interval
+// around pivot, swapping its endpoints, and a zero factor shrinks it into [pivot, pivot].
+// Empty intervals stay as they are.
+func Scale[T Ordered[T]](i Interval[T], pivot T, factor float64, s Scaler[T]) Interval[T] {
+	switch {
+	case i.IsEmpty():
+		return i
+	case factor == 0:
+		return New(ClosedEp(pivot), ClosedEp(pivot))
+	}
+	if i.Lower.Bounded() {
+		i.Lower.Value = s.Scale(i.Lower.Value, pivot, factor)
+	}
+	if i.Upper.Bounded() {
+		i.Upper.Value = s.Scale(i.Upper.Value, pivot, factor)
+	}
+	if factor < 0 {
+		i.Lower, i.Upper = i.Upper, i.Lower
+	}
+	if i.IsEmpty() {
+		return Interval[T]{}
+	}
+	return i
+}
@@ -0,0 +1,142 @@
+package interval
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestIntMetricAffine(t *testing.T) {
+	m := IntMetric{}
+	assertEqual(t, Int(3), m.Add(1, 2))
+	assertEqual(t, Int(-1), m.Sub(1, 2))
+	assertEqual(t, Int(math.MaxInt), m.Add(math.MaxInt-1, 2))
+	assertEqual(t, Int(math.MinInt), m.Add(math.MinInt+1, -2))
+	assertEqual(t, Int(math.MaxInt), m.Sub(0, math.MinInt))
+	assertEqual(t, Int(math.MinInt), m.Sub(math.MinInt+1, 2))
+	assertEqual(t, Int(5), m.Scale(3, 1, 2))
+	assertEqual(t, Int(-3), m.Scale(3, 1, -2))
+	assertEqual(t, Int(3), m.Scale(5, 1, 0.5))
+	assertEqual(t, Int(math.MaxInt), m.Scale(math.MaxInt, 0, 2))
+}
+
+func TestShift(t *testing.T) {
+	m := IntMetric{}
+	assertEqual(t, New(OpenEp(Int(3)), ClosedEp(Int(5))), Shift[Int](New(OpenEp(Int(1)), ClosedEp(Int(3))), 2, m))
+	assertEqual(t, New(UnboundedEp[Int](), OpenEp(Int(0))), Shift[Int](New(UnboundedEp[Int](), OpenEp(Int(3))), -3, m))
+	assertEqual(t, Interval[Int]{}, Shift[Int](New(ClosedEp(Int(math.MaxInt-2)), OpenEp(Int(math.MaxInt))), 5, m))
+	assertEqual(t, New(ClosedEp(Int(math.MaxInt)), ClosedEp(Int(math.MaxInt))), Shift[Int](New(ClosedEp(Int(math.MaxInt-1)), ClosedEp(Int(math.MaxInt))), 5, m))
+	assertEqual(t, New(ClosedEp(Int(math.MinInt)), OpenEp(Int(5))), Shift[Int](New(ClosedEp(Int(math.MinInt+1)), OpenEp(Int(10))), -5, m))
+
+	empty := New(ClosedEp(Int(3)), ClosedEp(Int(1)))
+	assertEqual(t, empty, Shift[Int](empty, 2, m))
+
+	day := time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)
+	booking := New(ClosedEp(Time(day)), OpenEp(Time(day.Add(time.Hour))))
+	assertEqual(
+		t,
+		New(ClosedEp(Time(day.Add(24*time.Hour))), OpenEp(Time(day.Add(25*time.Hour)))),
+		Shift[Time](booking, 24*time.Hour, TimeMetric{}),
+	)
+	assertEqual(
+		t,
+		New(
+			ClosedEp(Time(time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC))),
+			OpenEp(Time(time.Date(2020, 2, 29, 1, 0, 0, 0, time.UTC))),
+		),
+		Shift[Time](booking, Period{Months: 1}, TimeCalendar{}),
+	)
+}
+
+func TestExpand(t *testing.T) {
+	m := IntMetric{}
+	i := New(OpenEp(Int(10)), ClosedEp(Int(20)))
+	cases := []struct {
+		name         string
+		i            Interval[Int]
+		lower, upper int
+		want         Interval[Int]
+	}{
+		{"expand", i, 2, 3, New(OpenEp(Int(8)), ClosedEp(Int(23)))},
+		{"negative", i, -2, -3, New(OpenEp(Int(12)), ClosedEp(Int(17)))},
+		{"cross over", i, -6, -5, Interval[Int]{}},
+		{"unbounded", New(OpenEp(Int(10)), UnboundedEp[Int]()), 2, 3, New(OpenEp(Int(8)), UnboundedEp[Int]())},
+		{"empty", New(OpenEp(Int(10)), OpenEp(Int(11))), 2, 3, New(OpenEp(Int(10)), OpenEp(Int(11)))},
+		{"saturated", i, 2, math.MaxInt, New(OpenEp(Int(8)), ClosedEp(Int(math.MaxInt)))},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, Expand[Int](c.i, c.lower, c.upper, m))
+		})
+	}
+
+	t.Run("time", func(t *testing.T) {
+		t1 := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+		booking := New(ClosedEp(Time(t1)), OpenEp(Time(t1.Add(time.Hour))))
+		assertEqual(
+			t,
+			New(ClosedEp(Time(t1.Add(-15*time.Minute))), OpenEp(Time(t1.Add(75*time.Minute)))),
+			Expand[Time](booking, 15*time.Minute, 15*time.Minute, TimeMetric{}),
+		)
+	})
+}
+
+func TestShrink(t *testing.T) {
+	m := IntMetric{}
+	i := New(ClosedEp(Int(10)), OpenEp(Int(20)))
+	cases := []struct {
+		name         string
+		i            Interval[Int]
+		lower, upper int
+		want         Interval[Int]
+	}{
+		{"shrink", i, 2, 3, New(ClosedEp(Int(12)), OpenEp(Int(17)))},
+		{"to a point", New(ClosedEp(Int(10)), ClosedEp(Int(20))), 5, 5, New(ClosedEp(Int(15)), ClosedEp(Int(15)))},
+		{"meet", i, 5, 5, Interval[Int]{}},
+		{"cross over", i, 6, 5, Interval[Int]{}},
+		{"unbounded", New(UnboundedEp[Int](), OpenEp(Int(20))), 2, 3, New(UnboundedEp[Int](), OpenEp(Int(17)))},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, Shrink[Int](c.i, c.lower, c.upper, m))
+		})
+	}
+}
+
+func TestScale(t *testing.T) {
+	m := IntMetric{}
+	i := New(ClosedEp(Int(10)), OpenEp(Int(20)))
+	cases := []struct {
+		name   string
+		i      Interval[Int]
+		pivot  Int
+		factor float64
+		want   Interval[Int]
+	}{
+		{"double", i, 10, 2, New(ClosedEp(Int(10)), OpenEp(Int(30)))},
+		{"half around middle", i, 15, 0.5, New(ClosedEp(Int(13)), OpenEp(Int(18)))},
+		{"mirror", i, 0, -1, New(OpenEp(Int(-20)), ClosedEp(Int(-10)))},
+		{"zero", i, 5, 0, New(ClosedEp(Int(5)), ClosedEp(Int(5)))},
+		{"unbounded", New(ClosedEp(Int(10)), UnboundedEp[Int]()), 0, 3, New(ClosedEp(Int(30)), UnboundedEp[Int]())},
+		{"unbounded mirror", New(ClosedEp(Int(10)), UnboundedEp[Int]()), 0, -1, New(UnboundedEp[Int](), ClosedEp(Int(-10)))},
+		{"rounded to empty", New(OpenEp(Int(10)), OpenEp(Int(12))), 0, 0.1, Interval[Int]{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, Scale[Int](c.i, c.pivot, c.factor, m))
+		})
+	}
+
+	t.Run("time", func(t *testing.T) {
+		t1 := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+		i := New(ClosedEp(Time(t1)), OpenEp(Time(t1.Add(time.Hour))))
+		assertEqual(
+			t,
+			New(ClosedEp(Time(t1)), OpenEp(Time(t1.Add(90*time.Minute)))),
+			Scale[Time](i, Time(t1), 1.5, TimeMetric{}),
+		)
+	})
+}
@@ -0,0 +1,147 @@
+package interval
+
+// AllenRelation is one of the 13 relations of Allen's interval algebra.
+//
+// Endpoints are compared as positions on the extended line, so open and
+// closed endpoints are taken into account. For example, [1, 3) meets [3, 5],
+// [1, 3] overlaps [3, 5], and (1, 3) is before (3, 5) because the point 3
+// lies between them. Unbounded endpoints are treated as -inf or +inf.
+// The zero value AllenNone is used when either interval is empty.
+type AllenRelation int
+
+const (
+	AllenNone         AllenRelation = iota // either interval is empty
+	AllenBefore                            // i ends before i2 starts, with a gap between them
+	AllenMeets                             // i ends where i2 starts, with neither a gap nor a shared point
+	AllenOverlaps                          // i starts before i2 and ends inside i2
+	AllenStarts                            // i starts with i2 and ends before i2
+	AllenDuring                            // i starts after i2 and ends before i2
+	AllenFinishes                          // i starts after i2 and ends with i2
+	AllenEquals                            // i starts and ends with i2
+	AllenFinishedBy                        // inverse of AllenFinishes
+	AllenContains                          // inverse of AllenDuring
+	AllenStartedBy                         // inverse of AllenStarts
+	AllenOverlappedBy                      // inverse of AllenOverlaps
+	AllenMetBy                             // inverse of AllenMeets
+	AllenAfter                             // inverse of AllenBefore
+)
+
+var allenNames = [...]string{
+	AllenNone:         "none",
+	AllenBefore:       "before",
+	AllenMeets:        "meets",
+	AllenOverlaps:     "overlaps",
+	AllenStarts:       "starts",
+	AllenDuring:       "during",
+	AllenFinishes:     "finishes",
+	AllenEquals:       "equals",
+	AllenFinishedBy:   "finished by",
+	AllenContains:     "contains",
+	AllenStartedBy:    "started by",
+	AllenOverlappedBy: "overlapped by",
+	AllenMetBy:        "met by",
+	AllenAfter:        "after",
+}
+
+// String returns the name of relation.
+func (r AllenRelation) String() string {
+	if r < 0 || int(r) >= len(allenNames) {
+		return "unknown"
+	}
+	return allenNames[r]
+}
+
+// Inverse returns the relation of i2 to i when r is the relation of i to i2.
+func (r AllenRelation) Inverse() AllenRelation {
+	if r == AllenNone {
+		return AllenNone
+	}
+	return AllenAfter + AllenBefore - r
+}
+
+// Relation returns the relation of interval to other interval.
+// It returns AllenNone if either interval is empty.
+// Discrete intervals are compared in their canonical forms,
+// so [1, 2] meets [3, 4] for Int.
+func (i Interval[T]) Relation(i2 Interval[T]) AllenRelation {
+	d := domainOf[T]()
+	return d.canonical(i).relation(d.canonical(i2), d.compare)
+}
+
+// relation is Relation of canonical intervals.
+func (i Interval[T]) relation(i2 Interval[T], compare comparator[T]) AllenRelation {
+	if i.isEmpty(compare) || i2.isEmpty(compare) {
+		return AllenNone
+	}
+	switch c := compare.ep(i.Upper, true, i2.Lower, false); {
+	case c < 0:
+		return AllenBefore
+	case c == 0:
+		return AllenMeets
+	}
+	switch c := compare.ep(i2.Upper, true, i.Lower, false); {
+	case c < 0:
+		return AllenAfter
+	case c == 0:
+		return AllenMetBy
+	}
+
+	lower := compare.ep(i.Lower, false, i2.Lower, false)
+	upper := compare.ep(i.Upper, true, i2.Upper, true)
+	switch {
+	case lower == 0 && upper == 0:
+		return AllenEquals
+	case lower == 0 && upper < 0:
+		return AllenStarts
+	case lower == 0:
+		return AllenStartedBy
+	case upper == 0 && lower > 0:
+		return AllenFinishes
+	case upper == 0:
+		return AllenFinishedBy
+	case lower > 0 && upper < 0:
+		return AllenDuring
+	case lower < 0 && upper > 0:
+		return AllenContains
+	case lower < 0:
+		return AllenOverlaps
+	default:
+		return AllenOverlappedBy
+	}
+}
+
+// Meets returns true if interval ends exactly where other interval starts,
+// like [1, 3) and [3, 5].
+func (i Interval[T]) Meets(i2 Interval[T]) bool {
+	return i.Relation(i2) == AllenMeets
+}
+
+// MetBy returns true if other interval ends exactly where interval starts.
+func (i Interval[T]) MetBy(i2 Interval[T]) bool {
+	return i.Relation(i2) == AllenMetBy
+}
+
+// Starts returns true if interval starts with other interval and ends before it.
+func (i Interval[T]) Starts(i2 Interval[T]) bool {
+	return i.Relation(i2) == AllenStarts
+}
+
+// StartedBy returns true if other interval starts with interval and ends before it.
+func (i Interval[T]) StartedBy(i2 Interval[T]) bool {
+	return i.Relation(i2) == AllenStartedBy
+}
+
+// During returns true if interval lies strictly inside other interval.
+func (i Interval[T]) During(i2 Interval[T]) bool {
+	return i.Relation(i2) == AllenDuring
+}
+
+// Finishes returns true if interval ends with other interval and starts after it.
+func (i Interval[T]) Finishes(i2 Interval[T]) bool {
+	return i.Relation(i2) == AllenFinishes
+}
+
+// FinishedBy returns true if other interval ends with interval and starts after it.
+func (i Interval[T]) FinishedBy(i2 Interval[T]) bool {
+	return i.Relation(i2) == AllenFinishedBy
+}
@@ -0,0 +1,191 @@
+package interval
+
+import "testing"
+
+func testRelation[T Ordered[T]](t *testing.T, v1, v2, v3, v4 T) {
+	if !(v1.LessThan(v2) && v2.LessThan(v3) && v3.LessThan(v4)) {
+		t.Fatalf("must be v1 < v2 < v3 < v4. got v1=%v, v2=%v, v3=%v, v4=%v", v1, v2, v3, v4)
+	}
+
+	unbounded := UnboundedEp[T]()
+	cases := []struct {
+		name string
+		i    Interval[T]
+		i2   Interval[T]
+		want AllenRelation
+	}{
+		{
+			name: "i is empty",
+			i:    New(OpenEp(v1), OpenEp(v1)),
+			i2:   New(unbounded, unbounded),
+			want: AllenNone,
+		},
+		{
+			name: "before",
+			i:    New(ClosedEp(v1), ClosedEp(v2)),
+			i2:   New(ClosedEp(v3), ClosedEp(v4)),
+			want: AllenBefore,
+		},
+		{
+			name: "before, both open at the same value",
+			i:    New(ClosedEp(v1), OpenEp(v2)),
+			i2:   New(OpenEp(v2), ClosedEp(v4)),
+			want: AllenBefore,
+		},
+		{
+			name: "meets, upper open",
+			i:    New(ClosedEp(v1), OpenEp(v2)),
+			i2:   New(ClosedEp(v2), ClosedEp(v4)),
+			want: AllenMeets,
+		},
+		{
+			name: "meets, lower open",
+			i:    New(unbounded, ClosedEp(v2)),
+			i2:   New(OpenEp(v2), unbounded),
+			want: AllenMeets,
+		},
+		{
+			name: "overlaps at a single point",
+			i:    New(ClosedEp(v1), ClosedEp(v2)),
+			i2:   New(ClosedEp(v2), ClosedEp(v4)),
+			want: AllenOverlaps,
+		},
+		{
+			name: "overlaps",
+			i:    New(unbounded, OpenEp(v3)),
+			i2:   New(OpenEp(v2), unbounded),
+			want: AllenOverlaps,
+		},
+		{
+			name: "starts",
+			i:    New(ClosedEp(v1), OpenEp(v2)),
+			i2:   New(ClosedEp(v1), OpenEp(v3)),
+			want: AllenStarts,
+		},
+		{
+			name: "starts, both lower unbounded",
+			i:    New(unbounded, OpenEp(v2)),
+			i2:   New(unbounded, OpenEp(v3)),
+			want: AllenStarts,
+		},
+		{
+			name: "starts, same value, upper open",
+			i:    New(ClosedEp(v1), OpenEp(v3)),
+			i2:   New(ClosedEp(v1), ClosedEp(v3)),
+			want: AllenStarts,
+		},
+		{
+			name: "during",
+			i:    New(ClosedEp(v2), ClosedEp(v3)),
+			i2:   New(ClosedEp(v1), ClosedEp(v4)),
+			want: AllenDuring,
+		},
+		{
+			name: "during, same values",
+			i:    New(OpenEp(v1), OpenEp(v3)),
+			i2:   New(ClosedEp(v1), ClosedEp(v3)),
+			want: AllenDuring,
+		},
+		{
+			name: "finishes",
+			i:    New(OpenEp(v2), unbounded),
+			i2:   New(OpenEp(v1), unbounded),
+			want: AllenFinishes,
+		},
+		{
+			name: "equals",
+			i:    New(ClosedEp(v1), OpenEp(v2)),
+			i2:   New(ClosedEp(v1), OpenEp(v2)),
+			want: AllenEquals,
+		},
+		{
+			name: "equals, entire",
+			i:    New(unbounded, unbounded),
+			i2:   New(unbounded, unbounded),
+			want: AllenEquals,
+		},
+		{
+			name: "equals, single point",
+			i:    New(ClosedEp(v1), ClosedEp(v1)),
+			i2:   New(ClosedEp(v1), ClosedEp(v1)),
+			want: AllenEquals,
+		},
+		{
+			name: "contains",
+			i:    New(unbounded, unbounded),
+			i2:   New(ClosedEp(v1), ClosedEp(v2)),
+			want: AllenContains,
+		},
+		{
+			name: "after",
+			i:    New(OpenEp(v3), OpenEp(v4)),
+			i2:   New(OpenEp(v1), ClosedEp(v2)),
+			want: AllenAfter,
+		},
+		{
+			name: "met by",
+			i:    New(OpenEp(v3), OpenEp(v4)),
+			i2:   New(OpenEp(v1), ClosedEp(v3)),
+			want: AllenMetBy,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, c.i.Relation(c.i2))
+			assertEqual(t, c.want.Inverse(), c.i2.Relation(c.i))
+		})
+	}
+}
+
+func TestAllenRelation(t *testing.T) {
+	t.Run("Inverse", func(t *testing.T) {
+		cases := []struct {
+			r, want AllenRelation
+		}{
+			{AllenNone, AllenNone},
+			{AllenBefore, AllenAfter},
+			{AllenMeets, AllenMetBy},
+			{AllenOverlaps, AllenOverlappedBy},
+			{AllenStarts, AllenStartedBy},
+			{AllenDuring, AllenContains},
+			{AllenFinishes, AllenFinishedBy},
+			{AllenEquals, AllenEquals},
+		}
+		for _, c := range cases {
+			assertEqual(t, c.want, c.r.Inverse())
+			assertEqual(t, c.r, c.want.Inverse())
+		}
+	})
+	t.Run("String", func(t *testing.T) {
+		assertEqual(t, "none", AllenNone.String())
+		assertEqual(t, "met by", AllenMetBy.String())
+		assertEqual(t, "after", AllenAfter.String())
+		assertEqual(t, "unknown", AllenRelation(-1).String())
+		assertEqual(t, "unknown", AllenRelation(14).String())
+	})
+}
+
+func TestAllenPredicates(t *testing.T) {
+	i := New(ClosedEp(Int(2)), OpenEp(Int(4)))
+	cases := []struct {
+		name string
+		f    func(Interval[Int]) bool
+		i2   Interval[Int]
+	}{
+		{"Meets", i.Meets, New(ClosedEp(Int(4)), OpenEp(Int(5)))},
+		{"MetBy", i.MetBy, New(ClosedEp(Int(1)), OpenEp(Int(2)))},
+		{"Starts", i.Starts, New(ClosedEp(Int(2)), OpenEp(Int(5)))},
+		{"StartedBy", i.StartedBy, New(ClosedEp(Int(2)), OpenEp(Int(3)))},
+		{"During", i.During, New(ClosedEp(Int(1)), OpenEp(Int(5)))},
+		{"Finishes", i.Finishes, New(ClosedEp(Int(1)), OpenEp(Int(4)))},
+		{"FinishedBy", i.FinishedBy, New(ClosedEp(Int(3)), OpenEp(Int(4)))},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, true, c.f(c.i2))
+			assertEqual(t, false, c.f(i))
+		})
+	}
+}
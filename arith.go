@@ -0,0 +1,350 @@
+package interval
+
+import "math"
+
+// The functions below implement interval arithmetic on Interval[Float64].
+// Each result contains every value the operation yields for points of the operands,
+// even though floating-point operations round their results:
+// the endpoints are rounded outward, toward -inf for lower endpoints and +inf for
+// upper endpoints. Results are closed except for unbounded endpoints, so open
+// endpoints of operands are treated as closed, which only widens results.
+// Unbounded and infinite endpoints behave as -inf or +inf,
+// and empty operands or operands with NaN yield empty results.
+
+// Float64Add returns the interval of x + y for x in i and y in i2.
+func Float64Add(i, i2 Interval[Float64]) Interval[Float64] {
+	lo, hi, ok := floatBounds(i)
+	lo2, hi2, ok2 := floatBounds(i2)
+	if !ok || !ok2 {
+		return Interval[Float64]{}
+	}
+	return fromBounds(addRound(lo, lo2, false), addRound(hi, hi2, true))
+}
+
+// Float64Sub returns the interval of x - y for x in i and y in i2.
+func Float64Sub(i, i2 Interval[Float64]) Interval[Float64] {
+	lo, hi, ok := floatBounds(i)
+	lo2, hi2, ok2 := floatBounds(i2)
+	if !ok || !ok2 {
+		return Interval[Float64]{}
+	}
+	return fromBounds(addRound(lo, -hi2, false), addRound(hi, -lo2, true))
+}
+
+// Float64Mul returns the interval of x * y for x in i and y in i2.
+// 0 * inf is regarded as 0.
+func Float64Mul(i, i2 Interval[Float64]) Interval[Float64] {
+	lo, hi, ok := floatBounds(i)
+	lo2, hi2, ok2 := floatBounds(i2)
+	if !ok || !ok2 {
+		return Interval[Float64]{}
+	}
+	return fromBounds(
+		math.Min(
+			math.Min(mulRound(lo, lo2, false), mulRound(lo, hi2, false)),
+			math.Min(mulRound(hi, lo2, false), mulRound(hi, hi2, false)),
+		),
+		math.Max(
+			math.Max(mulRound(lo, lo2, true), mulRound(lo, hi2, true)),
+			math.Max(mulRound(hi, lo2, true), mulRound(hi, hi2, true)),
+		),
+	)
+}
+
+// Float64Div returns the intervals of x / y for x in i and non-zero y in i2.
+// If i2 contains zero, the result is unbounded and may consist of two intervals
+// in ascending order, as [1, 2] / [-1, 1] is (-inf, -1] and [1, +inf).
+// The result is entire if both intervals contain zero,
+// and nil if either is empty or i2 is [0, 0].
+func Float64Div(i, i2 Interval[Float64]) []Interval[Float64] {
+	lo, hi, ok := floatBounds(i)
+	lo2, hi2, ok2 := floatBounds(i2)
+	if !ok || !ok2 || (lo2 == 0 && hi2 == 0) {
+		return nil
+	}
+	inf := math.Inf(1)
+	switch {
+	case lo2 > 0 || hi2 < 0:
+		return []Interval[Float64]{fromBounds(
+			math.Min(
+				math.Min(divRound(lo, lo2, false), divRound(lo, hi2, false)),
+				math.Min(divRound(hi, lo2, false), divRound(hi, hi2, false)),
+			),
+			math.Max(
+				math.Max(divRound(lo, lo2, true), divRound(lo, hi2, true)),
+				math.Max(divRound(hi, lo2, true), divRound(hi, hi2, true)),
+			),
+		)}
+	case lo <= 0 && 0 <= hi:
+		return []Interval[Float64]{fromBounds(-inf, inf)}
+	}
+
+	// i lies on one side of zero, and i2 contains zero.
+	// v is the endpoint of i nearest to zero.
+	v := lo
+	if hi < 0 {
+		v = hi
+	}
+	var left, right []Interval[Float64]
+	if lo2 < 0 {
+		// y in [lo2, 0) gives x / y on the opposite side of v
+		if v > 0 {
+			left = []Interval[Float64]{fromBounds(-inf, divRound(v, lo2, true))}
+		} else {
+			right = []Interval[Float64]{fromBounds(divRound(v, lo2, false), inf)}
+		}
+	}
+	if hi2 > 0 {
+		// y in (0, hi2] gives x / y on the same side as v
+		if v > 0 {
+			right = []Interval[Float64]{fromBounds(divRound(v, hi2, false), inf)}
+		} else {
+			left = []Interval[Float64]{fromBounds(-inf, divRound(v, hi2, true))}
+		}
+	}
+	return append(left, right...)
+}
+
+// Float64Sqrt returns the interval of the square roots of non-negative x in i.
+// It returns an empty interval if i has no non-negative value.
+func Float64Sqrt(i Interval[Float64]) Interval[Float64] {
+	lo, hi, ok := floatBounds(i)
+	if !ok || hi < 0 {
+		return Interval[Float64]{}
+	}
+	return fromBounds(sqrtRound(math.Max(lo, 0), false), sqrtRound(hi, true))
+}
+
+// Float64Exp returns the interval of e**x for x in i.
+func Float64Exp(i Interval[Float64]) Interval[Float64] {
+	lo, hi, ok := floatBounds(i)
+	if !ok {
+		return Interval[Float64]{}
+	}
+	return fromBounds(math.Max(widen(math.Exp(lo), false), 0), widen(math.Exp(hi), true))
+}
+
+// Float64Log returns the interval of the natural logarithms of positive x in i.
+// It returns an empty interval if i has no positive value.
+func Float64Log(i Interval[Float64]) Interval[Float64] {
+	lo, hi, ok := floatBounds(i)
+	if !ok || hi <= 0 {
+		return Interval[Float64]{}
+	}
+	return fromBounds(widen(math.Log(math.Max(lo, 0)), false), widen(math.Log(hi), true))
+}
+
+// Float64Pow returns the interval of x**n for x in i.
+// For negative n, it returns the hull of 1 / x**-n,
+// which is entire if i contains zero inside for odd n.
+func Float64Pow(i Interval[Float64], n int) Interval[Float64] {
+	lo, hi, ok := floatBounds(i)
+	switch {
+	case !ok:
+		return Interval[Float64]{}
+	case n == 0:
+		return fromBounds(1, 1)
+	case n < 0:
+		var res Interval[Float64]
+		for _, r := range Float64Div(fromBounds(1, 1), Float64Pow(i, -n)) {
+			res = res.Hull(r)
+		}
+		return res
+	case n%2 == 1:
+		// x**n is increasing
+		return fromBounds(powRound(lo, n, false), powRound(hi, n, true))
+	case lo >= 0:
+		return fromBounds(powRound(lo, n, false), powRound(hi, n, true))
+	case hi <= 0:
+		return fromBounds(powRound(hi, n, false), powRound(lo, n, true))
+	default:
+		return fromBounds(0, powRound(math.Max(-lo, hi), n, true))
+	}
+}
+
+// Float64Sin returns the interval of sin(x) for x in i.
+func Float64Sin(i Interval[Float64]) Interval[Float64] {
+	return periodic(i, math.Sin, math.Pi/2)
+}
+
+// Float64Cos returns the interval of cos(x) for x in i.
+func Float64Cos(i Interval[Float64]) Interval[Float64] {
+	return periodic(i, math.Cos, 0)
+}
+
+// periodic returns the interval of f(x) for x in i,
+// where f is sin or cos taking its maximum 1 at peak + 2kπ
+// and its minimum -1 at peak + (2k+1)π.
+func periodic(i Interval[Float64], f func(float64) float64, peak float64) Interval[Float64] {
+	lo, hi, ok := floatBounds(i)
+	if !ok {
+		return Interval[Float64]{}
+	}
+	if hi-lo >= 2*math.Pi || math.IsInf(lo, 0) || math.IsInf(hi, 0) {
+		return fromBounds(-1, 1)
+	}
+	v, v2 := f(lo), f(hi)
+	min := widen(math.Min(v, v2), false)
+	max := widen(math.Max(v, v2), true)
+	if hasExtremum(lo, hi, peak) {
+		max = 1
+	}
+	if hasExtremum(lo, hi, peak+math.Pi) {
+		min = -1
+	}
+	return fromBounds(math.Max(min, -1), math.Min(max, 1))
+}
+
+// hasExtremum returns true if [lo, hi] may contain a point at + 2kπ for some integer k.
+// It errs on the side of true, since a false positive only widens the result.
+func hasExtremum(lo, hi, at float64) bool {
+	// error of the computed periods, growing with the magnitude of the operands
+	eps := 1e-9 + 1e-15*math.Max(math.Abs(lo), math.Abs(hi))
+	k := math.Ceil((lo-at)/(2*math.Pi) - eps)
+	return k <= math.Floor((hi-at)/(2*math.Pi)+eps)
+}
+
+// floatBounds returns the endpoints of interval as float64 values,
+// with unbounded endpoints as -inf and +inf.
+// It returns false if interval is empty or has NaN.
+func floatBounds(i Interval[Float64]) (lo, hi float64, ok bool) {
+	i, err := NewFloat64(i.Lower, i.Upper)
+	if err != nil || i.IsEmpty() {
+		return 0, 0, false
+	}
+	lo, hi = math.Inf(-1), math.Inf(1)
+	if i.Lower.Bounded() {
+		lo = float64(i.Lower.Value)
+	}
+	if i.Upper.Bounded() {
+		hi = float64(i.Upper.Value)
+	}
+	return lo, hi, true
+}
+
+// fromBounds returns the closed interval [lo, hi] with infinite endpoints as unbounded.
+// NaN bounds, which cannot be trusted, are widened to unbounded.
+func fromBounds(lo, hi float64) Interval[Float64] {
+	if math.IsNaN(lo) {
+		lo = math.Inf(-1)
+	}
+	if math.IsNaN(hi) {
+		hi = math.Inf(1)
+	}
+	i, _ := NewFloat64(ClosedEp(Float64(lo)), ClosedEp(Float64(hi)))
+	return i
+}
+
+// tiny is the magnitude below which the error terms of products and quotients
+// computed with math.FMA may underflow, so that their signs are unknown.
+const tiny = 0x1p-968
+
+// directed rounds r, the rounded result of an operation, further toward +inf if up
+// or toward -inf otherwise. e has the sign of the exact result minus r,
+// and NaN e means the sign is unknown, so r is always moved.
+func directed(r, e float64, up bool) float64 {
+	switch {
+	case up && !(e <= 0):
+		return math.Nextafter(r, math.Inf(1))
+	case !up && !(e >= 0):
+		return math.Nextafter(r, math.Inf(-1))
+	}
+	return r
+}
+
+// widen moves a result of the math package by one ulp toward +inf if up
+// or toward -inf otherwise, since it is accurate within one ulp.
+func widen(r float64, up bool) float64 {
+	return directed(r, math.NaN(), up)
+}
+
+// addRound returns a + b rounded toward +inf if up or toward -inf otherwise.
+func addRound(a, b float64, up bool) float64 {
+	s := a + b
+	if math.IsInf(s, 0) {
+		return directed(s, math.NaN(), up)
+	}
+	// TwoSum: s + e is exactly a + b
+	bb := s - a
+	e := (a - (s - bb)) + (b - bb)
+	return directed(s, e, up)
+}
+
+// mulRound returns a * b rounded toward +inf if up or toward -inf otherwise.
+func mulRound(a, b float64, up bool) float64 {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	p := a * b
+	e := math.NaN()
+	if !math.IsInf(p, 0) && math.Abs(p) >= tiny {
+		e = math.FMA(a, b, -p)
+	}
+	return directed(p, e, up)
+}
+
+// divRound returns a / b rounded toward +inf if up or toward -inf otherwise.
+// x / inf is regarded as 0 for finite x, and inf / inf is regarded as the widest of 0 and inf with the sign of the quotient.
+func divRound(a, b float64, up bool) float64 {
+	if a == 0 || (math.IsInf(b, 0) && !math.IsInf(a, 0)) {
+		return 0
+	}
+	q := a / b
+	if math.IsNaN(q) {
+		positive := math.Signbit(a) == math.Signbit(b)
+		switch {
+		case up && positive:
+			return math.Inf(1)
+		case !up && !positive:
+			return math.Inf(-1)
+		}
+		return 0
+	}
+	e := math.NaN()
+	if !math.IsInf(q, 0) && math.Abs(q) >= tiny && math.Abs(a) >= tiny {
+		// a - q*b has the sign of a/b - q if b is positive
+		e = math.FMA(-q, b, a)
+		if b < 0 {
+			e = -e
+		}
+	}
+	return directed(q, e, up)
+}
+
+// sqrtRound returns the square root of non-negative x
+// rounded toward +inf if up or toward -inf otherwise.
+func sqrtRound(x float64, up bool) float64 {
+	r := math.Sqrt(x)
+	if x == 0 || math.IsInf(x, 0) {
+		return r
+	}
+	e := math.NaN()
+	if x >= tiny {
+		// x - r*r has the sign of sqrt(x) - r
+		e = math.FMA(-r, r, x)
+	}
+	return math.Max(directed(r, e, up), 0)
+}
+
+// powRound returns x**n for positive n
+// rounded toward +inf if up or toward -inf otherwise.
+func powRound(x float64, n int, up bool) float64 {
+	if x < 0 {
+		// (-x)**n has the sign of x**n for even n and the opposite sign for odd n
+		r := powRound(-x, n, n%2 == 0 == up)
+		if n%2 == 1 {
+			return -r
+		}
+		return r
+	}
+	// every factor is non-negative, so rounding each product in one direction
+	// rounds the result in that direction
+	r := 1.0
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			r = mulRound(r, x, up)
+		}
+		x = mulRound(x, x, up)
+	}
+	return r
+}
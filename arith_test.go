@@ -0,0 +1,178 @@
+package interval
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// fi returns the interval [lo, hi] with infinite values as unbounded.
+func fi(lo, hi float64) Interval[Float64] {
+	return fromBounds(lo, hi)
+}
+
+func TestArithmetic(t *testing.T) {
+	inf := math.Inf(1)
+	entire := fi(-inf, inf)
+	cases := []struct {
+		name string
+		got  Interval[Float64]
+		want Interval[Float64]
+	}{
+		{"add exact", Float64Add(fi(1, 2), fi(3, 4)), fi(4, 6)},
+		{"add unbounded", Float64Add(fi(-inf, 2), fi(3, 4)), fi(-inf, 6)},
+		{"add overflow", Float64Add(fi(math.MaxFloat64, math.MaxFloat64), fi(math.MaxFloat64, math.MaxFloat64)), fi(math.MaxFloat64, inf)},
+		{"add empty", Float64Add(Interval[Float64]{}, fi(3, 4)), Interval[Float64]{}},
+		{"sub exact", Float64Sub(fi(1, 2), fi(3, 4)), fi(-3, -1)},
+		{"mul exact", Float64Mul(fi(-1, 2), fi(3, 4)), fi(-4, 8)},
+		{"mul zero and unbounded", Float64Mul(fi(0, 0), entire), fi(0, 0)},
+		{"mul unbounded", Float64Mul(fi(1, inf), fi(-2, -1)), fi(-inf, -1)},
+		{"sqrt exact", Float64Sqrt(fi(4, 9)), fi(2, 3)},
+		{"sqrt negative part", Float64Sqrt(fi(-4, 9)), fi(0, 3)},
+		{"sqrt negative", Float64Sqrt(fi(-4, -1)), Interval[Float64]{}},
+		{"exp unbounded", Float64Exp(fi(-inf, inf)), fi(0, inf)},
+		{"log zero", Float64Log(fi(0, 1)), fi(-inf, math.Nextafter(0, 1))},
+		{"log negative", Float64Log(fi(-2, 0)), Interval[Float64]{}},
+		{"pow even", Float64Pow(fi(-3, 2), 2), fi(0, 9)},
+		{"pow odd", Float64Pow(fi(-3, 2), 3), fi(-27, 8)},
+		{"pow even negative", Float64Pow(fi(-3, -2), 2), fi(4, 9)},
+		{"pow zero", Float64Pow(fi(-3, 2), 0), fi(1, 1)},
+		{"pow inverse", Float64Pow(fi(2, 4), -2), fi(0.0625, 0.25)},
+		{"pow inverse through zero", Float64Pow(fi(-2, 4), -1), entire},
+		{"pow inverse from zero", Float64Pow(fi(0, 4), -2), fi(0.0625, inf)},
+		{"sin wide", Float64Sin(fi(0, 7)), fi(-1, 1)},
+		{"sin unbounded", Float64Sin(fi(0, inf)), fi(-1, 1)},
+		{"cos zero", Float64Cos(fi(0, 0)), fi(math.Nextafter(1, 0), 1)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, c.got)
+		})
+	}
+}
+
+func TestDiv(t *testing.T) {
+	inf := math.Inf(1)
+	cases := []struct {
+		name  string
+		i, i2 Interval[Float64]
+		want  []Interval[Float64]
+	}{
+		{"exact", fi(1, 2), fi(4, 8), []Interval[Float64]{fi(0.125, 0.5)}},
+		{"negative", fi(1, 2), fi(-8, -4), []Interval[Float64]{fi(-0.5, -0.125)}},
+		{"unbounded divisor", fi(1, 2), fi(4, inf), []Interval[Float64]{fi(0, 0.5)}},
+		{"zero divisor", fi(1, 2), fi(0, 0), nil},
+		{"divisor through zero", fi(1, 2), fi(-1, 1), []Interval[Float64]{fi(-inf, -1), fi(1, inf)}},
+		{"divisor from zero", fi(1, 2), fi(0, 4), []Interval[Float64]{fi(0.25, inf)}},
+		{"divisor to zero", fi(1, 2), fi(-4, 0), []Interval[Float64]{fi(-inf, -0.25)}},
+		{"negative dividend", fi(-2, -1), fi(-1, 1), []Interval[Float64]{fi(-inf, -1), fi(1, inf)}},
+		{"negative dividend from zero", fi(-2, -1), fi(0, 4), []Interval[Float64]{fi(-inf, -0.25)}},
+		{"both through zero", fi(-1, 1), fi(-1, 1), []Interval[Float64]{fi(-inf, inf)}},
+		{"empty", Interval[Float64]{}, fi(1, 1), nil},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertDeepEqual(t, c.want, Float64Div(c.i, c.i2))
+		})
+	}
+}
+
+func TestArithmeticRounding(t *testing.T) {
+	a, b := 0.1, 0.2
+	// 0.1 + 0.2 is not representable, so the result has two neighboring bounds
+	sum := Float64Add(fi(a, a), fi(b, b))
+	assertEqual(t, math.Nextafter(float64(sum.Lower.Value), 1), float64(sum.Upper.Value))
+
+	// sqrt(2) is irrational
+	root := Float64Sqrt(fi(2, 2))
+	assertEqual(t, true, root.Lower.Value*root.Lower.Value < 2)
+	assertEqual(t, true, root.Upper.Value*root.Upper.Value > 2)
+}
+
+// TestArithmeticContainment checks the bounds of random operations
+// against the results computed exactly with math/big.
+func TestArithmeticContainment(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func() float64 {
+		return (r.Float64() - 0.5) * math.Pow(2, float64(r.Intn(80)-40))
+	}
+	exact := func(x float64) *big.Float {
+		return new(big.Float).SetPrec(2200).SetFloat64(x)
+	}
+	ops := []struct {
+		name string
+		f    func(i, i2 Interval[Float64]) Interval[Float64]
+		big  func(z, x, y *big.Float) *big.Float
+	}{
+		{"add", Float64Add, (*big.Float).Add},
+		{"sub", Float64Sub, (*big.Float).Sub},
+		{"mul", Float64Mul, (*big.Float).Mul},
+		{"div", func(i, i2 Interval[Float64]) Interval[Float64] {
+			return Float64Div(i, i2)[0]
+		}, (*big.Float).Quo},
+	}
+
+	for _, op := range ops {
+		t.Run(op.name, func(t *testing.T) {
+			for n := 0; n < 1000; n++ {
+				x, y := random(), random()
+				if y == 0 {
+					continue
+				}
+				got := op.f(fi(x, x), fi(y, y))
+				want := op.big(new(big.Float).SetPrec(2200), exact(x), exact(y))
+				lo, hi := exact(float64(got.Lower.Value)), exact(float64(got.Upper.Value))
+				if lo.Cmp(want) > 0 || hi.Cmp(want) < 0 {
+					t.Fatalf("%v %s %v: %v does not contain %v", x, op.name, y, got, want)
+				}
+				if math.Nextafter(math.Nextafter(float64(got.Lower.Value), math.Inf(1)), math.Inf(1)) < float64(got.Upper.Value) {
+					t.Fatalf("%v %s %v: %v is wider than two ulps", x, op.name, y, got)
+				}
+			}
+		})
+	}
+	t.Run("sqrt", func(t *testing.T) {
+		for n := 0; n < 1000; n++ {
+			x := math.Abs(random())
+			got := Float64Sqrt(fi(x, x))
+			want := new(big.Float).SetPrec(2200).Sqrt(exact(x))
+			lo, hi := exact(float64(got.Lower.Value)), exact(float64(got.Upper.Value))
+			if lo.Cmp(want) > 0 || hi.Cmp(want) < 0 {
+				t.Fatalf("sqrt %v: %v does not contain %v", x, got, want)
+			}
+		}
+	})
+}
+
+func TestTranscendentalContainment(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	funcs := []struct {
+		name string
+		f    func(Interval[Float64]) Interval[Float64]
+		g    func(float64) float64
+	}{
+		{"exp", Float64Exp, math.Exp},
+		{"log", Float64Log, math.Log},
+		{"sin", Float64Sin, math.Sin},
+		{"cos", Float64Cos, math.Cos},
+	}
+
+	for _, f := range funcs {
+		t.Run(f.name, func(t *testing.T) {
+			for n := 0; n < 1000; n++ {
+				lo := r.Float64() * 10
+				hi := lo + r.Float64()*4
+				got := f.f(fi(lo, hi))
+				for k := 0; k <= 100; k++ {
+					x := math.Min(lo+(hi-lo)*float64(k)/100, hi)
+					if y := f.g(x); !got.Contains(Float64(y)) {
+						t.Fatalf("%s of [%v, %v] = %v does not contain %v at %v", f.name, lo, hi, got, y, x)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package interval
+
+import "reflect"
+
+// Comparable is an interface for types that have a three-way comparison.
+// Compare returns a negative number, zero or a positive number
+// when the receiver is less than, equal to or greater than the argument.
+//
+// Types implementing both Ordered and Comparable are compared with a single
+// Compare call instead of LessThan and Equal, which pays off for values
+// whose comparison is expensive such as composite keys of long strings.
+// Compare is called through an interface, which allocates values other than pointers,
+// so only pointer types are compared with Compare, and the others with LessThan and Equal.
+// Comparing wraps a Comparable type of any kind to be compared with Compare.
+type Comparable[T any] interface {
+	Compare(T) int
+}
+
+// Comparing is a wrapper of a Comparable type.
+// It implements the Ordered and Comparable interfaces with Compare of V,
+// and is compared with a single Compare call without interfaces, even if T is not a pointer.
+type Comparing[T Comparable[T]] struct {
+	V T
+}
+
+// compareFuncer is implemented by the types of this package providing their comparison as a function,
+// which compares values without converting them to interfaces.
+type compareFuncer[T any] interface {
+	compareFunc() func(a, b T) int
+}
+
+// Equal checks if c is equal to c2.
+func (c Comparing[T]) Equal(c2 Comparing[T]) bool {
+	return c.V.Compare(c2.V) == 0
+}
+
+// LessThan checks if c is less than c2.
+func (c Comparing[T]) LessThan(c2 Comparing[T]) bool {
+	return c.V.Compare(c2.V) < 0
+}
+
+// Compare compares c and c2 with Compare of V.
+func (c Comparing[T]) Compare(c2 Comparing[T]) int {
+	return c.V.Compare(c2.V)
+}
+
+func (Comparing[T]) compareFunc() func(a, b Comparing[T]) int {
+	return Comparing[T].Compare
+}
+
+// CompareOrdered compares a and b with their LessThan and Equal methods.
+// It returns -1, 0 or 1 as Comparable.Compare does.
+func CompareOrdered[T Ordered[T]](a, b T) int {
+	switch {
+	case a.LessThan(b):
+		return -1
+	case a.Equal(b):
+		return 0
+	default:
+		return 1
+	}
+}
+
+// comparator compares values of T and returns a negative number,
+// zero or a positive number.
+type comparator[T Ordered[T]] struct {
+	compare func(a, b T) int
+}
+
+// comparer returns the comparator of T, which uses Compare if T is a pointer implementing Comparable
+// or is compared natively, otherwise CompareOrdered.
+func comparer[T Ordered[T]]() comparator[T] {
+	return domainOf[T]().compare
+}
+
+// newComparator returns the comparator of a type outside the type switch of domainOf.
+func newComparator[T Ordered[T]]() comparator[T] {
+	var zero T
+	if c, ok := any(zero).(compareFuncer[T]); ok {
+		return comparator[T]{compare: c.compareFunc()}
+	}
+	// pointers are converted to interfaces without allocating
+	if _, ok := any(zero).(Comparable[T]); ok && reflect.TypeFor[T]().Kind() == reflect.Pointer {
+		return comparator[T]{compare: func(a, b T) int {
+			return any(a).(Comparable[T]).Compare(b)
+		}}
+	}
+	return comparator[T]{compare: CompareOrdered[T]}
+}
+
+// cmp compares a and b.
+func (c comparator[T]) cmp(a, b T) int {
+	return c.compare(a, b)
+}
@@ -0,0 +1,290 @@
+package interval
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// key is a composite key ordered by its fields,
+// whose comparison is as expensive as the fields are long.
+type key struct {
+	tenant, id []byte
+}
+
+func (k *key) compare(k2 *key) int {
+	if c := bytes.Compare(k.tenant, k2.tenant); c != 0 {
+		return c
+	}
+	return bytes.Compare(k.id, k2.id)
+}
+
+// orderedKey implements Ordered only.
+type orderedKey struct{ key }
+
+func (k *orderedKey) Equal(k2 *orderedKey) bool {
+	return k.compare(&k2.key) == 0
+}
+
+func (k *orderedKey) LessThan(k2 *orderedKey) bool {
+	return k.compare(&k2.key) < 0
+}
+
+// comparableKey implements both Ordered and Comparable.
+type comparableKey struct{ orderedKey }
+
+func (k *comparableKey) Equal(k2 *comparableKey) bool {
+	return k.compare(&k2.key) == 0
+}
+
+func (k *comparableKey) LessThan(k2 *comparableKey) bool {
+	return k.compare(&k2.key) < 0
+}
+
+func (k *comparableKey) Compare(k2 *comparableKey) int {
+	return k.compare(&k2.key)
+}
+
+// valueKey is a composite key implementing both Ordered and Comparable by value.
+type valueKey struct {
+	tenant, id string
+}
+
+func (k valueKey) Equal(k2 valueKey) bool {
+	return k.Compare(k2) == 0
+}
+
+func (k valueKey) LessThan(k2 valueKey) bool {
+	return k.Compare(k2) < 0
+}
+
+func (k valueKey) Compare(k2 valueKey) int {
+	if c := strings.Compare(k.tenant, k2.tenant); c != 0 {
+		return c
+	}
+	return strings.Compare(k.id, k2.id)
+}
+
+// cmpInt implements Comparable only.
+type cmpInt int
+
+func (i cmpInt) Compare(i2 cmpInt) int {
+	switch {
+	case i < i2:
+		return -1
+	case i > i2:
+		return 1
+	}
+	return 0
+}
+
+func TestCompareOrdered(t *testing.T) {
+	assertEqual(t, -1, CompareOrdered(Int(1), Int(2)))
+	assertEqual(t, 0, CompareOrdered(Int(2), Int(2)))
+	assertEqual(t, 1, CompareOrdered(Int(3), Int(2)))
+}
+
+func TestComparing(t *testing.T) {
+	a, b, c := Comparing[cmpInt]{1}, Comparing[cmpInt]{2}, Comparing[cmpInt]{3}
+	assertEqual(t, true, a.LessThan(b))
+	assertEqual(t, false, b.LessThan(a))
+	assertEqual(t, true, a.Equal(a))
+	assertEqual(t, false, a.Equal(b))
+	assertEqual(t, -1, a.Compare(b))
+
+	i := New(ClosedEp(a), OpenEp(c))
+	assertEqual(t, true, i.Contains(a))
+	assertEqual(t, true, i.Contains(b))
+	assertEqual(t, false, i.Contains(c))
+	assertEqual(t, true, i.Before(New(ClosedEp(c), ClosedEp(c))))
+}
+
+func TestComparer(t *testing.T) {
+	keys := []string{"", "a", "ab", "b"}
+	for _, k := range keys {
+		for _, k2 := range keys {
+			o, o2 := newKeys(k, k2)
+			c, c2 := &comparableKey{*o}, &comparableKey{*o2}
+			want := CompareOrdered(o, o2)
+			assertEqual(t, want, comparer[*orderedKey]().cmp(o, o2))
+			assertEqual(t, want, sign(comparer[*comparableKey]().cmp(c, c2)))
+			assertEqual(t, want, comparer[String]().cmp(String(k), String(k2)))
+			assertEqual(t, want, comparer[Bytes]().cmp(Bytes(k), Bytes(k2)))
+		}
+	}
+}
+
+func newKeys(ids ...string) (*orderedKey, *orderedKey) {
+	return &orderedKey{key{id: []byte(ids[0])}}, &orderedKey{key{id: []byte(ids[1])}}
+}
+
+func TestComparerAllocs(t *testing.T) {
+	lower, p, upper := benchKeys()
+	i := New(ClosedEp(lower), OpenEp(upper))
+	i2 := New(ClosedEp(&comparableKey{*lower}), OpenEp(&comparableKey{*upper}))
+	p2 := &comparableKey{*p}
+	b, b2, b3 := benchBytes(lower, p, upper)
+	i3 := New(ClosedEp(b), OpenEp(b3))
+	i4 := New(ClosedEp(String(b)), OpenEp(String(b3)))
+	s2 := String(b2)
+	i5, i6 := New(ClosedEp(Int(1000)), OpenEp(Int(3000))), New(ClosedEp(Int(2000)), OpenEp(Int(4000)))
+	v, v2, v3 := benchValueKeys()
+	i7 := New(ClosedEp(v), OpenEp(v3))
+	i8 := New(ClosedEp(Comparing[valueKey]{v}), OpenEp(Comparing[valueKey]{v3}))
+	c2 := Comparing[valueKey]{v2}
+	for name, f := range map[string]func(){
+		"Ordered":         func() { i.Contains(p) },
+		"Comparable":      func() { i2.Contains(p2) },
+		"Bytes":           func() { i3.Contains(b2) },
+		"String":          func() { i4.Contains(s2) },
+		"Int":             func() { i5.Overlaps(i6) },
+		"ComparableValue": func() { i7.Contains(v2) },
+		"Comparing":       func() { i8.Contains(c2) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			assertEqual(t, 0.0, testing.AllocsPerRun(100, f))
+		})
+	}
+}
+
+// benchKeys returns keys with equal long tenants in distinct arrays,
+// so that each comparison scans all of their bytes.
+func benchKeys() (a, b, c *orderedKey) {
+	newKey := func(id string) *orderedKey {
+		return &orderedKey{key{tenant: bytes.Repeat([]byte("t"), 4096), id: []byte(id)}}
+	}
+	return newKey("a"), newKey("b"), newKey("c")
+}
+
+// benchValueKeys returns value keys with equal long tenants.
+func benchValueKeys() (a, b, c valueKey) {
+	tenant := strings.Repeat("t", 4096)
+	newKey := func(id string) valueKey {
+		return valueKey{tenant: strings.Clone(tenant), id: id}
+	}
+	return newKey("a"), newKey("b"), newKey("c")
+}
+
+// baselineContains is Contains comparing values with LessThan and Equal,
+// as before Comparable was introduced, to measure what Compare saves.
+func baselineContains[T Ordered[T]](i Interval[T], p T) bool {
+	if i.IsEmpty() {
+		return false
+	}
+	if i.Lower.Bounded() && (p.LessThan(i.Lower.Value) || (p.Equal(i.Lower.Value) && !i.Lower.Closed)) {
+		return false
+	}
+	if i.Upper.Bounded() && (i.Upper.Value.LessThan(p) || (i.Upper.Value.Equal(p) && !i.Upper.Closed)) {
+		return false
+	}
+	return true
+}
+
+// baselineBefore is Before comparing values with LessThan and Equal.
+func baselineBefore[T Ordered[T]](i, i2 Interval[T]) bool {
+	if i.IsEmpty() || i2.IsEmpty() {
+		return false
+	}
+	if i.Upper.Bounded() && i2.Lower.Bounded() {
+		return i.Upper.Value.LessThan(i2.Lower.Value) || (i.Upper.Value.Equal(i2.Lower.Value) && (!i.Upper.Closed || !i2.Lower.Closed))
+	}
+	return false
+}
+
+// benchBytes returns the tenants and ids of keys joined together.
+func benchBytes(k, k2, k3 *orderedKey) (Bytes, Bytes, Bytes) {
+	join := func(k *orderedKey) Bytes {
+		return Bytes(append(bytes.Clone(k.tenant), k.id...))
+	}
+	return join(k), join(k2), join(k3)
+}
+
+func benchContains[T Ordered[T]](b *testing.B, lower, p, upper T) {
+	benchContainsFunc(b, lower, p, upper, Interval[T].Contains)
+}
+
+func benchContainsFunc[T Ordered[T]](b *testing.B, lower, p, upper T, contains func(Interval[T], T) bool) {
+	i := New(ClosedEp(lower), ClosedEp(upper))
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		contains(i, p)
+	}
+}
+
+func benchBefore[T Ordered[T]](b *testing.B, v, v2, v3 T) {
+	benchBeforeFunc(b, v, v2, v3, Interval[T].Before)
+}
+
+func benchBeforeFunc[T Ordered[T]](b *testing.B, v, v2, v3 T, before func(Interval[T], Interval[T]) bool) {
+	i, i2 := New(ClosedEp(v), ClosedEp(v2)), New(OpenEp(v2), ClosedEp(v3))
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		before(i, i2)
+	}
+}
+
+func BenchmarkContains(b *testing.B) {
+	k, k2, k3 := benchKeys()
+	b.Run("Baseline", func(b *testing.B) {
+		benchContainsFunc(b, k, k2, k3, baselineContains)
+	})
+	b.Run("Ordered", func(b *testing.B) {
+		benchContains(b, k, k2, k3)
+	})
+	b.Run("Comparable", func(b *testing.B) {
+		benchContains(b, &comparableKey{*k}, &comparableKey{*k2}, &comparableKey{*k3})
+	})
+	v, v2, v3 := benchBytes(k, k2, k3)
+	b.Run("Bytes", func(b *testing.B) {
+		benchContains(b, v, v2, v3)
+	})
+	b.Run("String", func(b *testing.B) {
+		benchContains(b, String(v), String(v2), String(v3))
+	})
+	b.Run("Int", func(b *testing.B) {
+		benchContains(b, Int(1000), Int(2000), Int(3000))
+	})
+	c, c2, c3 := benchValueKeys()
+	b.Run("ValueBaseline", func(b *testing.B) {
+		benchContainsFunc(b, c, c2, c3, baselineContains)
+	})
+	b.Run("Value", func(b *testing.B) {
+		benchContains(b, c, c2, c3)
+	})
+	b.Run("Comparing", func(b *testing.B) {
+		benchContains(b, Comparing[valueKey]{c}, Comparing[valueKey]{c2}, Comparing[valueKey]{c3})
+	})
+}
+
+func BenchmarkBefore(b *testing.B) {
+	k, k2, k3 := benchKeys()
+	b.Run("Baseline", func(b *testing.B) {
+		benchBeforeFunc(b, k, k2, k3, baselineBefore)
+	})
+	b.Run("Ordered", func(b *testing.B) {
+		benchBefore(b, k, k2, k3)
+	})
+	b.Run("Comparable", func(b *testing.B) {
+		benchBefore(b, &comparableKey{*k}, &comparableKey{*k2}, &comparableKey{*k3})
+	})
+	v, v2, v3 := benchBytes(k, k2, k3)
+	b.Run("Bytes", func(b *testing.B) {
+		benchBefore(b, v, v2, v3)
+	})
+	b.Run("String", func(b *testing.B) {
+		benchBefore(b, String(v), String(v2), String(v3))
+	})
+	b.Run("Int", func(b *testing.B) {
+		benchBefore(b, Int(1000), Int(2000), Int(3000))
+	})
+	c, c2, c3 := benchValueKeys()
+	b.Run("ValueBaseline", func(b *testing.B) {
+		benchBeforeFunc(b, c, c2, c3, baselineBefore)
+	})
+	b.Run("Value", func(b *testing.B) {
+		benchBefore(b, c, c2, c3)
+	})
+	b.Run("Comparing", func(b *testing.B) {
+		benchBefore(b, Comparing[valueKey]{c}, Comparing[valueKey]{c2}, Comparing[valueKey]{c3})
+	})
+}
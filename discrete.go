@@ -0,0 +1,197 @@
+package interval
+
+import (
+	"cmp"
+	"math"
+	"reflect"
+	"sync"
+)
+
+// Discrete is an optional interface for types whose values are isolated from each other,
+// such as integers. When T implements Discrete, intervals of T are compared by the points
+// they actually contain, so [1, 3] and [1, 4) are equal and (1, 2) is empty.
+type Discrete[T any] interface {
+	// Next returns the least value greater than the receiver.
+	// It returns false if the receiver is the maximum value.
+	Next() (T, bool)
+	// Prev returns the greatest value less than the receiver.
+	// It returns false if the receiver is the minimum value.
+	Prev() (T, bool)
+	// Steps returns how many times Next must be applied to the receiver to reach to.
+	// to must not be less than the receiver.
+	Steps(to T) uint64
+}
+
+// Canonical returns the closed-open form of interval if T implements Discrete.
+// For example, (1, 3] becomes [2, 4). An endpoint at the maximum value stays closed,
+// and unbounded endpoints are kept as they are.
+// Empty intervals become the zero value of Interval.
+// If T does not implement Discrete, interval is returned as it is.
+func (i Interval[T]) Canonical() Interval[T] {
+	return domainOf[T]().canonical(i)
+}
+
+// domain holds how an operation compares and steps values of T.
+// Operations look it up once and canonicalize each operand once.
+type domain[T Ordered[T]] struct {
+	compare comparator[T]
+	// next and prev are nil if T does not implement Discrete.
+	next, prev func(T) (T, bool)
+}
+
+// domains caches the domains of the types outside this package by their reflect.Type,
+// since looking them up by type assertions costs more than a cheap operation.
+var domains sync.Map
+
+// domainOf returns the domain of T.
+// The types of this package are compared and stepped without boxing their values into interfaces,
+// which would allocate on each step, and the others are looked up once.
+func domainOf[T Ordered[T]]() domain[T] {
+	var zero T
+	switch any(zero).(type) {
+	case Int:
+		return nativeDomain[T](cmp.Compare[Int], Int.Next, Int.Prev)
+	case Int8:
+		return nativeDomain[T](cmp.Compare[Int8], Int8.Next, Int8.Prev)
+	case Int16:
+		return nativeDomain[T](cmp.Compare[Int16], Int16.Next, Int16.Prev)
+	case Int32:
+		return nativeDomain[T](cmp.Compare[Int32], Int32.Next, Int32.Prev)
+	case Int64:
+		return nativeDomain[T](cmp.Compare[Int64], Int64.Next, Int64.Prev)
+	case Uint:
+		return nativeDomain[T](cmp.Compare[Uint], Uint.Next, Uint.Prev)
+	case Uint8:
+		return nativeDomain[T](cmp.Compare[Uint8], Uint8.Next, Uint8.Prev)
+	case Uint16:
+		return nativeDomain[T](cmp.Compare[Uint16], Uint16.Next, Uint16.Prev)
+	case Uint32:
+		return nativeDomain[T](cmp.Compare[Uint32], Uint32.Next, Uint32.Prev)
+	case Uint64:
+		return nativeDomain[T](cmp.Compare[Uint64], Uint64.Next, Uint64.Prev)
+	case Float32:
+		return nativeDomain[T](cmp.Compare[Float32], nil, nil)
+	case Float64:
+		return nativeDomain[T](cmp.Compare[Float64], nil, nil)
+	case Time:
+		return nativeDomain[T](compareTime, nil, nil)
+	case String:
+		return nativeDomain[T](String.Compare, nil, nil)
+	case Bytes:
+		return nativeDomain[T](Bytes.Compare, nil, nil)
+	}
+
+	t := reflect.TypeFor[T]()
+	if d, ok := domains.Load(t); ok {
+		return d.(domain[T])
+	}
+	d := domain[T]{compare: newComparator[T]()}
+	if _, ok := any(zero).(Discrete[T]); ok {
+		d.next = func(v T) (T, bool) {
+			return any(v).(Discrete[T]).Next()
+		}
+		d.prev = func(v T) (T, bool) {
+			return any(v).(Discrete[T]).Prev()
+		}
+	}
+	domains.Store(t, d)
+	return d
+}
+
+// nativeDomain returns the domain of T comparing and stepping values by the functions of U, where T is U.
+// Functions and method expressions of concrete types are static, so no closure is allocated.
+func nativeDomain[T Ordered[T], U any](compare func(a, b U) int, next, prev func(U) (U, bool)) domain[T] {
+	d := domain[T]{compare: comparator[T]{compare: any(compare).(func(a, b T) int)}}
+	if next != nil {
+		d.next, d.prev = any(next).(func(T) (T, bool)), any(prev).(func(T) (T, bool))
+	}
+	return d
+}
+
+// canonical returns the closed-open form of interval if T implements Discrete,
+// where empty intervals become the zero value, otherwise interval as it is.
+// It is small enough to be inlined, so non-discrete intervals are returned without a call.
+func (d domain[T]) canonical(i Interval[T]) Interval[T] {
+	if d.next == nil {
+		return i
+	}
+	return d.discrete(i)
+}
+
+// discrete is canonical of discrete interval.
+func (d domain[T]) discrete(i Interval[T]) Interval[T] {
+	if i = d.step(i); i.isEmpty(d.compare) {
+		return Interval[T]{}
+	}
+	return i
+}
+
+// closedOpen is canonical which leaves empty intervals as they are,
+// for operations checking emptiness by themselves.
+func (d domain[T]) closedOpen(i Interval[T]) Interval[T] {
+	if d.next == nil {
+		return i
+	}
+	return d.step(i)
+}
+
+// step returns discrete interval with an open lower endpoint and a closed upper endpoint
+// moved to their neighbors. Intervals opening at the maximum value become the zero value.
+func (d domain[T]) step(i Interval[T]) Interval[T] {
+	if i.Lower.Bounded() && !i.Lower.Closed {
+		n, ok := d.next(i.Lower.Value)
+		if !ok {
+			return Interval[T]{}
+		}
+		i.Lower = ClosedEp(n)
+	}
+	if i.Upper.Bounded() && i.Upper.Closed {
+		if n, ok := d.next(i.Upper.Value); ok {
+			i.Upper = OpenEp(n)
+		}
+	}
+	return i
+}
+
+// empty returns true if no points are contained in interval.
+func (d domain[T]) empty(i Interval[T]) bool {
+	return d.canonical(i).isEmpty(d.compare)
+}
+
+// Count returns the number of points contained in interval.
+// It returns false if T does not implement Discrete, interval is unbounded and not empty,
+// or the number exceeds the maximum uint64, as for all values of a 64-bit type.
+func (i Interval[T]) Count() (uint64, bool) {
+	d := domainOf[T]()
+	if d.next == nil {
+		return 0, false
+	}
+	c := d.canonical(i)
+	if c.isEmpty(d.compare) {
+		return 0, true
+	}
+	if c.Lower.Unbounded || c.Upper.Unbounded {
+		return 0, false
+	}
+	n := any(c.Lower.Value).(Discrete[T]).Steps(c.Upper.Value)
+	if c.Upper.Closed {
+		if n == math.MaxUint64 {
+			return 0, false
+		}
+		n++
+	}
+	return n, true
+}
+
+// Equal returns true if both intervals contain the same points.
+// Any two empty intervals are equal, and endpoint values are compared with T.Equal.
+// If T implements Discrete, intervals are compared in their canonical forms.
+func (i Interval[T]) Equal(i2 Interval[T]) bool {
+	d := domainOf[T]()
+	c, c2 := d.canonical(i), d.canonical(i2)
+	if e, e2 := c.isEmpty(d.compare), c2.isEmpty(d.compare); e || e2 {
+		return e && e2
+	}
+	return d.compare.ep(c.Lower, false, c2.Lower, false) == 0 &&
+		d.compare.ep(c.Upper, true, c2.Upper, true) == 0
+}
@@ -0,0 +1,250 @@
+package interval
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCanonical(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	cases := []struct {
+		name     string
+		interval Interval[Int]
+		want     Interval[Int]
+	}{
+		{
+			name:     "closed-open",
+			interval: New(ClosedEp(Int(1)), OpenEp(Int(4))),
+			want:     New(ClosedEp(Int(1)), OpenEp(Int(4))),
+		},
+		{
+			name:     "closed",
+			interval: New(ClosedEp(Int(1)), ClosedEp(Int(3))),
+			want:     New(ClosedEp(Int(1)), OpenEp(Int(4))),
+		},
+		{
+			name:     "open",
+			interval: New(OpenEp(Int(0)), OpenEp(Int(4))),
+			want:     New(ClosedEp(Int(1)), OpenEp(Int(4))),
+		},
+		{
+			name:     "no points",
+			interval: New(OpenEp(Int(1)), OpenEp(Int(2))),
+			want:     Interval[Int]{},
+		},
+		{
+			name:     "unbounded",
+			interval: New(unbounded, ClosedEp(Int(3))),
+			want:     New(unbounded, OpenEp(Int(4))),
+		},
+		{
+			name:     "maximum value",
+			interval: New(OpenEp(Int(math.MaxInt-1)), ClosedEp(Int(math.MaxInt))),
+			want:     New(ClosedEp(Int(math.MaxInt)), ClosedEp(Int(math.MaxInt))),
+		},
+		{
+			name:     "open at maximum value",
+			interval: New(OpenEp(Int(math.MaxInt)), unbounded),
+			want:     Interval[Int]{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, c.interval.Canonical())
+		})
+	}
+
+	t.Run("not discrete", func(t *testing.T) {
+		i := New(OpenEp(Time{}), ClosedEp(Time{}))
+		assertEqual(t, i, i.Canonical())
+	})
+}
+
+func TestDiscreteIntervals(t *testing.T) {
+	assertEqual(t, true, New(OpenEp(Int(1)), OpenEp(Int(2))).IsEmpty())
+	assertEqual(t, false, New(OpenEp(Int(1)), OpenEp(Int(3))).IsEmpty())
+	assertEqual(t, false, New(OpenEp(Int(1)), OpenEp(Int(3))).Overlaps(New(OpenEp(Int(2)), OpenEp(Int(4)))))
+	assertEqual(t, true, New(ClosedEp(Int(1)), ClosedEp(Int(2))).Before(New(ClosedEp(Int(3)), ClosedEp(Int(4)))))
+	assertEqual(t, AllenMeets, New(ClosedEp(Int(1)), ClosedEp(Int(2))).Relation(New(ClosedEp(Int(3)), ClosedEp(Int(4)))))
+}
+
+func TestCount(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	cases := []struct {
+		name     string
+		interval Interval[Int]
+		want     uint64
+		ok       bool
+	}{
+		{
+			name:     "empty",
+			interval: New(OpenEp(Int(1)), OpenEp(Int(2))),
+			want:     0,
+			ok:       true,
+		},
+		{
+			name:     "closed",
+			interval: New(ClosedEp(Int(1)), ClosedEp(Int(3))),
+			want:     3,
+			ok:       true,
+		},
+		{
+			name:     "open",
+			interval: New(OpenEp(Int(-3)), OpenEp(Int(3))),
+			want:     5,
+			ok:       true,
+		},
+		{
+			name:     "maximum value",
+			interval: New(ClosedEp(Int(math.MaxInt-1)), ClosedEp(Int(math.MaxInt))),
+			want:     2,
+			ok:       true,
+		},
+		{
+			name:     "all values",
+			interval: New(ClosedEp(Int(math.MinInt)), ClosedEp(Int(math.MaxInt))),
+			want:     0,
+			ok:       false,
+		},
+		{
+			name:     "all but one value",
+			interval: New(ClosedEp(Int(math.MinInt)), OpenEp(Int(math.MaxInt))),
+			want:     math.MaxUint64,
+			ok:       true,
+		},
+		{
+			name:     "unbounded",
+			interval: New(ClosedEp(Int(1)), unbounded),
+			want:     0,
+			ok:       false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			n, ok := c.interval.Count()
+			assertEqual(t, c.want, n)
+			assertEqual(t, c.ok, ok)
+		})
+	}
+
+	t.Run("not discrete", func(t *testing.T) {
+		_, ok := New(ClosedEp(Time{}), ClosedEp(Time{})).Count()
+		assertEqual(t, false, ok)
+	})
+
+	t.Run("all uint64 values", func(t *testing.T) {
+		_, ok := New(ClosedEp(Uint64(0)), ClosedEp(Uint64(math.MaxUint64))).Count()
+		assertEqual(t, false, ok)
+	})
+}
+
+func TestEqual(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	t1 := Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	t2 := Time(time.Date(2020, 1, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60)))
+	t3 := Time(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))
+
+	cases := []struct {
+		name string
+		eq   bool
+		want bool
+	}{
+		{
+			name: "empty intervals",
+			eq:   New(OpenEp(Int(3)), OpenEp(Int(3))).Equal(Interval[Int]{}),
+			want: true,
+		},
+		{
+			name: "empty and non-empty",
+			eq:   Interval[Int]{}.Equal(New(ClosedEp(Int(0)), ClosedEp(Int(0)))),
+			want: false,
+		},
+		{
+			name: "same points, different endpoints",
+			eq:   New(ClosedEp(Int(1)), ClosedEp(Int(3))).Equal(New(OpenEp(Int(0)), OpenEp(Int(4)))),
+			want: true,
+		},
+		{
+			name: "different points",
+			eq:   New(ClosedEp(Int(1)), ClosedEp(Int(3))).Equal(New(ClosedEp(Int(1)), ClosedEp(Int(4)))),
+			want: false,
+		},
+		{
+			name: "unbounded",
+			eq:   New(unbounded, ClosedEp(Int(3))).Equal(New(unbounded, OpenEp(Int(4)))),
+			want: true,
+		},
+		{
+			name: "unbounded and bounded",
+			eq:   New(unbounded, ClosedEp(Int(3))).Equal(New(ClosedEp(Int(3)), ClosedEp(Int(3)))),
+			want: false,
+		},
+		{
+			name: "values compared with Equal",
+			eq:   New(ClosedEp(t1), OpenEp(t3)).Equal(New(ClosedEp(t2), OpenEp(t3))),
+			want: true,
+		},
+		{
+			name: "closedness differs",
+			eq:   New(ClosedEp(t1), OpenEp(t3)).Equal(New(ClosedEp(t1), ClosedEp(t3))),
+			want: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, c.eq)
+		})
+	}
+}
+
+// steppedInt is a discrete type other than the integer wrappers,
+// counting the calls of Next and Prev.
+type steppedInt int
+
+var stepCount int
+
+func (i steppedInt) Equal(i2 steppedInt) bool    { return i == i2 }
+func (i steppedInt) LessThan(i2 steppedInt) bool { return i < i2 }
+func (i steppedInt) Steps(i2 steppedInt) uint64  { return uint64(i2 - i) }
+
+func (i steppedInt) Next() (steppedInt, bool) {
+	stepCount++
+	return i + 1, true
+}
+
+func (i steppedInt) Prev() (steppedInt, bool) {
+	stepCount++
+	return i - 1, true
+}
+
+func TestCanonicalizedOnce(t *testing.T) {
+	i, i2 := New(OpenEp(steppedInt(1)), ClosedEp(steppedInt(3))), New(OpenEp(steppedInt(2)), ClosedEp(steppedInt(5)))
+	cases := []struct {
+		name string
+		f    func()
+	}{
+		{"IsEmpty", func() { i.IsEmpty() }},
+		{"Overlaps", func() { i.Overlaps(i2) }},
+		{"Before", func() { i.Before(i2) }},
+		{"Equal", func() { i.Equal(i2) }},
+		{"Relation", func() { i.Relation(i2) }},
+		{"Encloses", func() { i.Encloses(i2) }},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			stepCount = 0
+			c.f()
+			// each endpoint is stepped once
+			want := 4
+			if c.name == "IsEmpty" {
+				want = 2
+			}
+			assertEqual(t, want, stepCount)
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package interval
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrInconsistentEndpoint indicates that an endpoint is both closed and unbounded,
+// or unbounded with a value.
+var ErrInconsistentEndpoint = errors.New("interval: inconsistent endpoint")
+
+type endpointJSON struct {
+	Value     json.RawMessage `json:"value,omitempty"`
+	Closed    *bool           `json:"closed,omitempty"`
+	Unbounded bool            `json:"unbounded,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+// A bounded endpoint is encoded as {"value":1,"closed":true}
+// and an unbounded endpoint as {"unbounded":true}.
+func (e Endpoint[T]) MarshalJSON() ([]byte, error) {
+	if e.Unbounded {
+		return json.Marshal(endpointJSON{Unbounded: true})
+	}
+	v, err := json.Marshal(e.Value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(endpointJSON{Value: v, Closed: &e.Closed})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// A missing "closed" is regarded as false.
+// It returns ErrInconsistentEndpoint if an unbounded endpoint has "value" or "closed": true.
+func (e *Endpoint[T]) UnmarshalJSON(data []byte) error {
+	var j endpointJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	if j.Unbounded {
+		if j.Value != nil || (j.Closed != nil && *j.Closed) {
+			return ErrInconsistentEndpoint
+		}
+		*e = UnboundedEp[T]()
+		return nil
+	}
+	if j.Value == nil {
+		return errors.New("interval: bounded endpoint without value")
+	}
+	var v T
+	if err := json.Unmarshal(j.Value, &v); err != nil {
+		return err
+	}
+	*e = Endpoint[T]{Value: v, Closed: j.Closed != nil && *j.Closed}
+	return nil
+}
+
+type intervalJSON[T Ordered[T]] struct {
+	Lower *Endpoint[T] `json:"lower"`
+	Upper *Endpoint[T] `json:"upper"`
+}
+
+// MarshalJSON implements json.Marshaler.
+// Interval is encoded as {"lower":{...},"upper":{...}} with the JSON form of Endpoint.
+func (i Interval[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(intervalJSON[T]{Lower: &i.Lower, Upper: &i.Upper})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Both "lower" and "upper" are required.
+func (i *Interval[T]) UnmarshalJSON(data []byte) error {
+	var j intervalJSON[T]
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	if j.Lower == nil || j.Upper == nil {
+		return errors.New("interval: both lower and upper are required")
+	}
+	*i = New(*j.Lower, *j.Upper)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// Interval is encoded in the standard notation like [1, 3),
+// where values are encoded by their MarshalText if T implements encoding.TextMarshaler.
+// Values which Parse would not read back as they are, such as ones containing separators
+// or spelling infinity, are quoted in Go syntax like ["a, b", c].
+func (i Interval[T]) MarshalText() ([]byte, error) {
+	n, err := i.notation(func(v T) (string, error) {
+		s, err := marshalValue(v)
+		if err != nil || !needsQuote(s) {
+			return s, err
+		}
+		return strconv.Quote(s), nil
+	})
+	return []byte(n), err
+}
+
+// needsQuote returns true if value in the standard notation must be quoted to be parsed as it is.
+func needsQuote(value string) bool {
+	inf := strings.TrimLeft(value, "+-")
+	return value == "" || strings.TrimSpace(value) != value || strings.ContainsAny(value, `,;"`) ||
+		strings.EqualFold(inf, "inf") || inf == "∞"
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts what Parse accepts, and values are decoded by their UnmarshalText.
+// *T must implement encoding.TextUnmarshaler unless T is a numeric, string or []byte kind.
+func (i *Interval[T]) UnmarshalText(text []byte) error {
+	res, err := Parse(string(text), unmarshalValue[T])
+	if err != nil {
+		return err
+	}
+	*i = res
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// Endpoint is encoded like "closed 1", "open 1" or "unbounded",
+// where values are encoded by their MarshalText if T implements encoding.TextMarshaler.
+func (e Endpoint[T]) MarshalText() ([]byte, error) {
+	switch {
+	case e.Unbounded:
+		return []byte("unbounded"), nil
+	case e.Closed:
+		v, err := marshalValue(e.Value)
+		return []byte("closed " + v), err
+	default:
+		v, err := marshalValue(e.Value)
+		return []byte("open " + v), err
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// *T must implement encoding.TextUnmarshaler unless T is a numeric, string or []byte kind.
+func (e *Endpoint[T]) UnmarshalText(text []byte) error {
+	s := string(text)
+	if s == "unbounded" {
+		*e = UnboundedEp[T]()
+		return nil
+	}
+	kind, value, ok := strings.Cut(s, " ")
+	if !ok || (kind != "closed" && kind != "open") {
+		return fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+	v, err := unmarshalValue[T](value)
+	if err != nil {
+		return err
+	}
+	*e = Endpoint[T]{Value: v, Closed: kind == "closed"}
+	return nil
+}
+
+// marshalValue encodes v by its MarshalText, or by fmt if it is not a encoding.TextMarshaler.
+func marshalValue[T any](v T) (string, error) {
+	if m, ok := any(v).(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		return string(b), err
+	}
+	return fmt.Sprint(v), nil
+}
+
+// unmarshalValue decodes s by UnmarshalText of *T.
+// If *T is not a encoding.TextUnmarshaler, s is parsed by parseBasic.
+func unmarshalValue[T any](s string) (T, error) {
+	var v T
+	if u, ok := any(&v).(encoding.TextUnmarshaler); ok {
+		err := u.UnmarshalText([]byte(s))
+		return v, err
+	}
+	err := parseBasic(s, &v)
+	return v, err
+}
+
+// parseBasic parses s into v, a pointer to a value of a numeric, string or []byte kind.
+func parseBasic(s string, v any) error {
+	rv := reflect.ValueOf(v).Elem()
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+	case reflect.String:
+		rv.SetString(s)
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("interval: cannot parse %q into %s", s, rv.Type())
+		}
+		rv.SetBytes([]byte(s))
+	default:
+		return fmt.Errorf("interval: cannot parse %q into %s", s, rv.Type())
+	}
+	return nil
+}
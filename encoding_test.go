@@ -0,0 +1,275 @@
+package interval
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestIntervalJSON(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	cases := []struct {
+		name     string
+		interval Interval[Int]
+		json     string
+	}{
+		{
+			name:     "bounded",
+			interval: New(ClosedEp(Int(1)), OpenEp(Int(3))),
+			json:     `{"lower":{"value":1,"closed":true},"upper":{"value":3,"closed":false}}`,
+		},
+		{
+			name:     "unbounded",
+			interval: New(OpenEp(Int(-1)), unbounded),
+			json:     `{"lower":{"value":-1,"closed":false},"upper":{"unbounded":true}}`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b, err := json.Marshal(c.interval)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, c.json, string(b))
+
+			var got Interval[Int]
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, c.interval, got)
+		})
+	}
+
+	t.Run("time", func(t *testing.T) {
+		i := New(
+			ClosedEp(Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))),
+			OpenEp(Time(time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC))),
+		)
+		b, err := json.Marshal(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, `{"lower":{"value":"2020-01-01T00:00:00Z","closed":true},"upper":{"value":"2020-01-02T03:04:05.000000006Z","closed":false}}`, string(b))
+
+		var got Interval[Time]
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, true, i.Equal(got))
+	})
+
+	t.Run("missing closed", func(t *testing.T) {
+		var got Interval[Int]
+		if err := json.Unmarshal([]byte(`{"lower":{"value":1},"upper":{"value":3,"closed":true}}`), &got); err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, New(OpenEp(Int(1)), ClosedEp(Int(3))), got)
+	})
+}
+
+func TestIntervalJSONError(t *testing.T) {
+	cases := []struct {
+		name         string
+		json         string
+		inconsistent bool
+	}{
+		{
+			name:         "unbounded and closed",
+			json:         `{"lower":{"unbounded":true,"closed":true},"upper":{"unbounded":true}}`,
+			inconsistent: true,
+		},
+		{
+			name:         "unbounded with value",
+			json:         `{"lower":{"unbounded":true},"upper":{"unbounded":true,"value":1}}`,
+			inconsistent: true,
+		},
+		{
+			name: "bounded without value",
+			json: `{"lower":{"closed":true},"upper":{"unbounded":true}}`,
+		},
+		{
+			name: "missing upper",
+			json: `{"lower":{"unbounded":true}}`,
+		},
+		{
+			name: "invalid value",
+			json: `{"lower":{"value":"a"},"upper":{"unbounded":true}}`,
+		},
+		{
+			name: "not an object",
+			json: `[1, 3]`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var got Interval[Int]
+			err := json.Unmarshal([]byte(c.json), &got)
+			if err == nil {
+				t.Fatal("want error, got nil")
+			}
+			assertEqual(t, c.inconsistent, errors.Is(err, ErrInconsistentEndpoint))
+		})
+	}
+}
+
+func TestIntervalText(t *testing.T) {
+	cases := []struct {
+		name     string
+		interval Interval[Int]
+		text     string
+	}{
+		{
+			name:     "bounded",
+			interval: New(ClosedEp(Int(1)), OpenEp(Int(3))),
+			text:     "[1, 3)",
+		},
+		{
+			name:     "unbounded",
+			interval: New(UnboundedEp[Int](), ClosedEp(Int(3))),
+			text:     "(-inf, 3]",
+		},
+		{
+			name:     "empty",
+			interval: Interval[Int]{},
+			text:     "∅",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b, err := c.interval.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, c.text, string(b))
+
+			var got Interval[Int]
+			if err := got.UnmarshalText(b); err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, c.interval, got)
+		})
+	}
+
+	t.Run("time", func(t *testing.T) {
+		var got Interval[Time]
+		if err := got.UnmarshalText([]byte("[2020-01-01T00:00:00Z, 2020-01-02T00:00:00+09:00)")); err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, true, got.Equal(New(
+			ClosedEp(Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))),
+			OpenEp(Time(time.Date(2020, 1, 1, 15, 0, 0, 0, time.UTC))),
+		)))
+	})
+
+	t.Run("quoted", func(t *testing.T) {
+		for _, c := range []struct {
+			interval Interval[String]
+			text     string
+		}{
+			{New(ClosedEp(String("a, b")), ClosedEp(String("c"))), `["a, b", c]`},
+			{New(OpenEp(String("")), OpenEp(String("x; y"))), `("", "x; y")`},
+			{New(ClosedEp(String(" a")), OpenEp(String(`say "hi"`))), `[" a", "say \"hi\"")`},
+			{New(ClosedEp(String("-inf")), ClosedEp(String("∞"))), `["-inf", "∞"]`},
+			{New(ClosedEp(String("[a")), ClosedEp(String("b)"))), `[[a, b)]`},
+		} {
+			b, err := c.interval.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, c.text, string(b))
+
+			var got Interval[String]
+			if err := got.UnmarshalText(b); err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, c.interval, got)
+		}
+
+		for _, s := range []string{`["a, b]`, `["a\q", b]`, `[a, b, c]`} {
+			var got Interval[String]
+			if err := got.UnmarshalText([]byte(s)); !errors.Is(err, ErrSyntax) {
+				t.Errorf("want ErrSyntax for %s, got %v", s, err)
+			}
+		}
+	})
+
+	t.Run("not a TextUnmarshaler", func(t *testing.T) {
+		var got Interval[plain]
+		if err := got.UnmarshalText([]byte("[1, 3)")); err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, New(ClosedEp(plain(1)), OpenEp(plain(3))), got)
+		b, err := got.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, "[1, 3)", string(b))
+
+		var got2 Interval[pair]
+		if err := got2.UnmarshalText([]byte("[1, 3)")); err == nil {
+			t.Fatal("want error, got nil")
+		}
+	})
+}
+
+func TestEndpointText(t *testing.T) {
+	cases := []struct {
+		endpoint Endpoint[Int]
+		text     string
+	}{
+		{endpoint: ClosedEp(Int(1)), text: "closed 1"},
+		{endpoint: OpenEp(Int(-1)), text: "open -1"},
+		{endpoint: UnboundedEp[Int](), text: "unbounded"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.text, func(t *testing.T) {
+			b, err := c.endpoint.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, c.text, string(b))
+
+			var got Endpoint[Int]
+			if err := got.UnmarshalText(b); err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, c.endpoint, got)
+		})
+	}
+
+	for _, s := range []string{"", "closed", "half 1", "open a"} {
+		var got Endpoint[Int]
+		if err := got.UnmarshalText([]byte(s)); err == nil {
+			t.Errorf("want error for %q, got nil", s)
+		}
+	}
+}
+
+// plain implements Ordered only.
+type plain int
+
+func (p plain) Equal(p2 plain) bool {
+	return p == p2
+}
+
+func (p plain) LessThan(p2 plain) bool {
+	return p < p2
+}
+
+// pair implements Ordered only, and is not a basic kind.
+type pair struct {
+	a, b int
+}
+
+func (p pair) Equal(p2 pair) bool {
+	return p == p2
+}
+
+func (p pair) LessThan(p2 pair) bool {
+	return p.a < p2.a || (p.a == p2.a && p.b < p2.b)
+}
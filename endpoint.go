@@ -46,3 +46,50 @@ func (e Endpoint[T]) Bounded() bool {
 func (e Endpoint[T]) equalAndBothClosed(e2 Endpoint[T]) bool {
 	return e.Value.Equal(e2.Value) && e.Closed && e2.Closed
 }
+
+// ep compares the positions of two endpoints on the extended line
+// and returns a negative number, zero or a positive number.
+// upper and upper2 tell whether e and e2 are used as upper endpoints.
+// An unbounded lower endpoint is -inf and an unbounded upper endpoint is +inf.
+// When the values are equal, a closed lower endpoint and an open upper endpoint
+// are placed just before the value, and the others just after it.
+func (c comparator[T]) ep(e Endpoint[T], upper bool, e2 Endpoint[T], upper2 bool) int {
+	if e.Unbounded || e2.Unbounded {
+		return infSign(e, upper) - infSign(e2, upper2)
+	}
+	if v := c.cmp(e.Value, e2.Value); v != 0 {
+		return v
+	}
+	return side(e, upper) - side(e2, upper2)
+}
+
+// infSign returns -1 for -inf, 1 for +inf and 0 for bounded endpoints.
+func infSign[T Ordered[T]](e Endpoint[T], upper bool) int {
+	switch {
+	case e.Bounded():
+		return 0
+	case upper:
+		return 1
+	default:
+		return -1
+	}
+}
+
+func side[T Ordered[T]](e Endpoint[T], upper bool) int {
+	if e.Closed == upper {
+		return 1
+	}
+	return -1
+}
+
+// flip returns the endpoint with the same value and the opposite closedness.
+// It turns the bound of an interval into the bound of its complement.
+func (e Endpoint[T]) flip() Endpoint[T] {
+	if e.Unbounded {
+		return e
+	}
+	return Endpoint[T]{
+		Value:  e.Value,
+		Closed: !e.Closed,
+	}
+}
@@ -1,6 +1,9 @@
 package interval
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func assertEqual(t *testing.T, want, got any) {
 	t.Helper()
@@ -9,6 +12,13 @@ func assertEqual(t *testing.T, want, got any) {
 	}
 }
 
+func assertDeepEqual(t *testing.T, want, got any) {
+	t.Helper()
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
 func testNewEndpoint[T Ordered[T]](t *testing.T, v T) {
 	t.Run("open", func(t *testing.T) {
 		assertEqual(
@@ -127,3 +137,43 @@ func TestBounded(t *testing.T) {
 	assertEqual(t, true, Endpoint[Int]{}.Bounded())
 	assertEqual(t, false, Endpoint[Int]{Unbounded: true}.Bounded())
 }
+
+func TestCompareEp(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	cases := []struct {
+		name   string
+		e      Endpoint[Int]
+		upper  bool
+		e2     Endpoint[Int]
+		upper2 bool
+		want   int
+	}{
+		{"-inf, -inf", unbounded, false, unbounded, false, 0},
+		{"+inf, +inf", unbounded, true, unbounded, true, 0},
+		{"-inf, +inf", unbounded, false, unbounded, true, -1},
+		{"-inf, bounded", unbounded, false, ClosedEp(Int(1)), false, -1},
+		{"+inf, bounded", unbounded, true, ClosedEp(Int(1)), true, 1},
+		{"less value", OpenEp(Int(1)), false, ClosedEp(Int(2)), false, -1},
+		{"greater value", ClosedEp(Int(2)), true, OpenEp(Int(1)), true, 1},
+		{"closed lower, open lower", ClosedEp(Int(1)), false, OpenEp(Int(1)), false, -1},
+		{"open upper, closed upper", OpenEp(Int(1)), true, ClosedEp(Int(1)), true, -1},
+		{"open upper, closed lower", OpenEp(Int(1)), true, ClosedEp(Int(1)), false, 0},
+		{"closed upper, open lower", ClosedEp(Int(1)), true, OpenEp(Int(1)), false, 0},
+		{"closed upper, closed lower", ClosedEp(Int(1)), true, ClosedEp(Int(1)), false, 1},
+	}
+
+	compare := comparer[Int]()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := compare.ep(c.e, c.upper, c.e2, c.upper2)
+			assertEqual(t, c.want, sign(got))
+			assertEqual(t, -c.want, sign(compare.ep(c.e2, c.upper2, c.e, c.upper)))
+		})
+	}
+}
+
+func TestFlip(t *testing.T) {
+	assertEqual(t, ClosedEp(Int(1)), OpenEp(Int(1)).flip())
+	assertEqual(t, OpenEp(Int(1)), ClosedEp(Int(1)).flip())
+	assertEqual(t, UnboundedEp[Int](), UnboundedEp[Int]().flip())
+}
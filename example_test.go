@@ -2,6 +2,7 @@ package interval_test
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/mokeko/interval"
@@ -54,6 +55,25 @@ func Example_time() {
 	// true
 }
 
+func Example_notation() {
+	type Int = interval.Int // wrapper type for int
+
+	i, err := interval.Parse("[1, 3)", func(s string) (Int, error) {
+		n, err := strconv.Atoi(s)
+		return Int(n), err
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(i.Contains(Int(3))) // false
+	fmt.Println(i)                  // [1, 3)
+
+	// Output:
+	// false
+	// [1, 3)
+}
+
 func Example_customTypes() {
 	// [1.0, 2.0)
 	i := interval.New(
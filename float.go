@@ -0,0 +1,89 @@
+package interval
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrNaN indicates that an endpoint of a floating-point interval is NaN.
+var ErrNaN = errors.New("interval: NaN endpoint")
+
+// NewFloat64 returns an interval with given endpoints like New,
+// mapping infinite values to the extended line.
+// -Inf as the lower endpoint and +Inf as the upper endpoint become unbounded,
+// whether they are open or closed, and +Inf as the lower endpoint or
+// -Inf as the upper endpoint makes the interval empty.
+// It returns ErrNaN if either endpoint is NaN.
+func NewFloat64(lower, upper Endpoint[Float64]) (Interval[Float64], error) {
+	if isNaNEp(lower) || isNaNEp(upper) {
+		return Interval[Float64]{}, ErrNaN
+	}
+	if lower.Bounded() && math.IsInf(float64(lower.Value), 0) {
+		if lower.Value > 0 {
+			return Interval[Float64]{}, nil
+		}
+		lower = UnboundedEp[Float64]()
+	}
+	if upper.Bounded() && math.IsInf(float64(upper.Value), 0) {
+		if upper.Value < 0 {
+			return Interval[Float64]{}, nil
+		}
+		upper = UnboundedEp[Float64]()
+	}
+	return New(lower, upper), nil
+}
+
+// Float64Between returns the closed interval [lower, upper] built by NewFloat64.
+func Float64Between(lower, upper float64) (Interval[Float64], error) {
+	return NewFloat64(ClosedEp(Float64(lower)), ClosedEp(Float64(upper)))
+}
+
+func isNaNEp(e Endpoint[Float64]) bool {
+	return e.Bounded() && math.IsNaN(float64(e.Value))
+}
+
+// isNaN returns true if v is NaN of the floating-point types of this package.
+func isNaN[T any](v T) bool {
+	switch v := any(v).(type) {
+	case Float64:
+		return math.IsNaN(float64(v))
+	case Float32:
+		return math.IsNaN(float64(v))
+	case Native[float64]:
+		return math.IsNaN(v.V)
+	case Native[float32]:
+		return math.IsNaN(float64(v.V))
+	}
+	return false
+}
+
+// ApproxEqual returns true if the intervals are equal within given tolerance.
+// Bounded endpoints match when their values differ by at most tol,
+// or by at most tol times the greater magnitude of them, so tol works both
+// as an absolute and a relative tolerance. Closedness is ignored since it moves
+// no endpoint by any distance, and unbounded endpoints match only each other.
+// All empty intervals are approximately equal, and NaN endpoints match nothing.
+func ApproxEqual(i, i2 Interval[Float64], tol float64) bool {
+	if isNaNEp(i.Lower) || isNaNEp(i.Upper) || isNaNEp(i2.Lower) || isNaNEp(i2.Upper) {
+		return false
+	}
+	if e, e2 := i.IsEmpty(), i2.IsEmpty(); e || e2 {
+		return e && e2
+	}
+	return approxEqualEp(i.Lower, i2.Lower, tol) && approxEqualEp(i.Upper, i2.Upper, tol)
+}
+
+func approxEqualEp(e, e2 Endpoint[Float64], tol float64) bool {
+	if e.Unbounded || e2.Unbounded {
+		return e.Unbounded == e2.Unbounded
+	}
+	v, v2 := float64(e.Value), float64(e2.Value)
+	if v == v2 {
+		return true
+	}
+	if math.IsInf(v, 0) || math.IsInf(v2, 0) {
+		return false
+	}
+	d := math.Abs(v - v2)
+	return d <= tol || d <= tol*math.Max(math.Abs(v), math.Abs(v2))
+}
@@ -0,0 +1,151 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewFloat64(t *testing.T) {
+	inf, nan := Float64(math.Inf(1)), Float64(math.NaN())
+	unbounded := UnboundedEp[Float64]()
+	cases := []struct {
+		name         string
+		lower, upper Endpoint[Float64]
+		want         Interval[Float64]
+		err          error
+	}{
+		{
+			name:  "finite",
+			lower: ClosedEp(Float64(1)),
+			upper: OpenEp(Float64(2)),
+			want:  New(ClosedEp(Float64(1)), OpenEp(Float64(2))),
+		},
+		{
+			name:  "infinite",
+			lower: ClosedEp(-inf),
+			upper: OpenEp(inf),
+			want:  New(unbounded, unbounded),
+		},
+		{
+			name:  "unbounded",
+			lower: unbounded,
+			upper: ClosedEp(Float64(2)),
+			want:  New(unbounded, ClosedEp(Float64(2))),
+		},
+		{
+			name:  "+inf lower",
+			lower: ClosedEp(inf),
+			upper: ClosedEp(inf),
+			want:  Interval[Float64]{},
+		},
+		{
+			name:  "-inf upper",
+			lower: unbounded,
+			upper: OpenEp(-inf),
+			want:  Interval[Float64]{},
+		},
+		{
+			name:  "nan lower",
+			lower: ClosedEp(nan),
+			upper: ClosedEp(Float64(2)),
+			err:   ErrNaN,
+		},
+		{
+			name:  "nan upper",
+			lower: ClosedEp(-inf),
+			upper: OpenEp(nan),
+			err:   ErrNaN,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := NewFloat64(c.lower, c.upper)
+			if !errors.Is(err, c.err) {
+				t.Fatalf("want error %v, got %v", c.err, err)
+			}
+			assertEqual(t, c.want, got)
+		})
+	}
+}
+
+func TestContainsNaN(t *testing.T) {
+	nan := math.NaN()
+	cases := []struct {
+		name string
+		i    Interval[Float64]
+	}{
+		{"at most", AtMost(Float64(5))},
+		{"at least", AtLeast(Float64(-5))},
+		{"less than", LessThan(Float64(5))},
+		{"entire", Entire[Float64]()},
+		{"closed", New(ClosedEp(Float64(-5)), ClosedEp(Float64(5)))},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, false, c.i.Contains(Float64(nan)))
+			assertEqual(t, true, c.i.Contains(Float64(0)))
+			_, ok := c.i.Clamp(Float64(nan))
+			assertEqual(t, false, ok)
+			assertEqual(t, false, NewSet(c.i).Contains(Float64(nan)))
+
+			var tree Tree[Float64, int]
+			tree.Insert(c.i, 1)
+			assertEqual(t, 0, len(tree.Stab(Float64(nan))))
+		})
+	}
+
+	assertEqual(t, false, Entire[Float32]().Contains(Float32(nan)))
+	assertEqual(t, false, AtMost(Native[float64]{5}).Contains(Native[float64]{nan}))
+	assertEqual(t, false, Entire[Native[float32]]().Contains(Native[float32]{float32(nan)}))
+}
+
+func TestFloat64Between(t *testing.T) {
+	i, err := Float64Between(math.Inf(-1), 3)
+	assertEqual(t, nil, err)
+	assertEqual(t, New(UnboundedEp[Float64](), ClosedEp(Float64(3))), i)
+	assertEqual(t, true, i.Contains(-1e308))
+	assertEqual(t, false, i.Contains(Float64(math.Inf(1))))
+
+	_, err = Float64Between(0, math.NaN())
+	assertEqual(t, ErrNaN, err)
+}
+
+func TestApproxEqual(t *testing.T) {
+	f := func(lower, upper float64) Interval[Float64] {
+		i, err := Float64Between(lower, upper)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return i
+	}
+	// computed at run time, unlike constant expressions
+	a, b := 0.1, 0.2
+	cases := []struct {
+		name  string
+		i, i2 Interval[Float64]
+		tol   float64
+		want  bool
+	}{
+		{"computed", f(a+b, 1), f(0.3, 1), 1e-9, true},
+		{"exact", f(a+b, 1), f(0.3, 1), 0, false},
+		{"relative", f(1e12, 2e12), f(1e12+1, 2e12), 1e-9, true},
+		{"too far", f(0, 1), f(0, 1.1), 1e-9, false},
+		{"closedness", f(0, 1), New(OpenEp(Float64(0)), OpenEp(Float64(1))), 0, true},
+		{"unbounded", f(math.Inf(-1), 1), f(math.Inf(-1), 1), 0, true},
+		{"unbounded and bounded", f(math.Inf(-1), 1), f(-1e308, 1), 1, false},
+		{"infinite value", New(ClosedEp(Float64(math.Inf(-1))), ClosedEp(Float64(1))), f(-1e308, 1), 1, false},
+		{"empty", f(2, 1), Interval[Float64]{}, 0, true},
+		{"empty and non-empty", f(2, 1), f(1, 1), 0, false},
+		{"nan", New(ClosedEp(Float64(math.NaN())), ClosedEp(Float64(1))), f(0, 1), 1, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, ApproxEqual(c.i, c.i2, c.tol))
+			assertEqual(t, c.want, ApproxEqual(c.i2, c.i, c.tol))
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrSyntax indicates that a string is not in interval notation.
+var ErrSyntax = errors.New("interval: invalid syntax")
+
+// String returns interval in the standard notation, like [1, 3), (-inf, 5] or ∅.
+func (i Interval[T]) String() string {
+	return fmt.Sprint(i)
+}
+
+// Format implements fmt.Formatter.
+// The verb and its flags and precision are applied to each endpoint value,
+// so %.2f prints [1.00, 2.50) for float values.
+// The width pads the whole notation, so %10v prints "    [1, 3]" and %-10v prints "[1, 3]    ".
+// %#v prints the Go syntax representation of interval.
+func (i Interval[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "interval.Interval[%T]{Lower:%#v, Upper:%#v}", i.Lower.Value, i.Lower, i.Upper)
+		return
+	}
+	format := valueFormat(f, verb)
+	n, _ := i.notation(func(v T) (string, error) {
+		return fmt.Sprintf(format, v), nil
+	})
+	pad(f, n)
+}
+
+// notation returns interval in the standard notation with values formatted by value.
+func (i Interval[T]) notation(value func(T) (string, error)) (string, error) {
+	if i.IsEmpty() {
+		return "∅", nil
+	}
+	var b strings.Builder
+	if i.Lower.Closed && i.Lower.Bounded() {
+		b.WriteString("[")
+	} else {
+		b.WriteString("(")
+	}
+	if i.Lower.Unbounded {
+		b.WriteString("-inf")
+	} else {
+		v, err := value(i.Lower.Value)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(v)
+	}
+	b.WriteString(", ")
+	if i.Upper.Unbounded {
+		b.WriteString("+inf")
+	} else {
+		v, err := value(i.Upper.Value)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(v)
+	}
+	if i.Upper.Closed && i.Upper.Bounded() {
+		b.WriteString("]")
+	} else {
+		b.WriteString(")")
+	}
+	return b.String(), nil
+}
+
+// String returns endpoint like "closed 1", "open 1" or "unbounded".
+func (e Endpoint[T]) String() string {
+	return fmt.Sprint(e)
+}
+
+// Format implements fmt.Formatter.
+// The verb and its flags and precision are applied to the endpoint value,
+// and the width pads the whole endpoint.
+func (e Endpoint[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "interval.Endpoint[%T]{Value:%#v, Closed:%t, Unbounded:%t}", e.Value, e.Value, e.Closed, e.Unbounded)
+		return
+	}
+	switch {
+	case e.Unbounded:
+		pad(f, "unbounded")
+	case e.Closed:
+		pad(f, "closed "+fmt.Sprintf(valueFormat(f, verb), e.Value))
+	default:
+		pad(f, "open "+fmt.Sprintf(valueFormat(f, verb), e.Value))
+	}
+}
+
+// pad writes s padded with spaces to the width of f,
+// on the right if the minus flag is set and on the left otherwise.
+func pad(f fmt.State, s string) {
+	w, ok := f.Width()
+	if n := utf8.RuneCountInString(s); ok && n < w {
+		if f.Flag('-') {
+			s += strings.Repeat(" ", w-n)
+		} else {
+			s = strings.Repeat(" ", w-n) + s
+		}
+	}
+	io.WriteString(f, s)
+}
+
+// valueFormat returns the format string for values with the verb, flags and precision of f.
+// The width is not included since it pads the whole notation.
+func valueFormat(f fmt.State, verb rune) string {
+	var b strings.Builder
+	b.WriteByte('%')
+	for _, c := range "+-# 0" {
+		if f.Flag(int(c)) {
+			b.WriteRune(c)
+		}
+	}
+	if p, ok := f.Precision(); ok {
+		fmt.Fprintf(&b, ".%d", p)
+	}
+	b.WriteRune(verb)
+	return b.String()
+}
+
+// Parse parses an interval in the standard notation, like [1, 3) or (-inf, 5].
+// Endpoint values are parsed by parse.
+//
+// The reversed brackets of ISO 31-11 such as ]1, 3[ are also accepted,
+// and the endpoints may be separated by ";" instead of ",".
+// Unbounded endpoints are written as inf, ∞ with an optional sign,
+// and the empty interval as ∅, {} or empty.
+// Values may be quoted in Go syntax like ["a, b", c] to contain separators, as MarshalText writes them.
+func Parse[T Ordered[T]](s string, parse func(string) (T, error)) (Interval[T], error) {
+	s = strings.TrimSpace(s)
+	switch s {
+	case "∅", "{}", "empty":
+		return Interval[T]{}, nil
+	}
+	if len(s) < 2 || !strings.ContainsAny(s[:1], "[(]") || !strings.ContainsAny(s[len(s)-1:], "])[") {
+		return Interval[T]{}, fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+
+	body := s[1 : len(s)-1]
+	sep := byte(',')
+	if len(separators(body, ';')) == 1 {
+		sep = ';'
+	}
+	seps := separators(body, sep)
+	if len(seps) != 1 {
+		return Interval[T]{}, fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+	l, u := body[:seps[0]], body[seps[0]+1:]
+
+	lower, err := parseEndpoint(strings.TrimSpace(l), s[0] == '[', "-", parse)
+	if err != nil {
+		return Interval[T]{}, fmt.Errorf("interval: parsing %q: %w", s, err)
+	}
+	upper, err := parseEndpoint(strings.TrimSpace(u), s[len(s)-1] == ']', "+", parse)
+	if err != nil {
+		return Interval[T]{}, fmt.Errorf("interval: parsing %q: %w", s, err)
+	}
+	return New(lower, upper), nil
+}
+
+// separators returns the indices of sep in s outside quoted values.
+// An unterminated quote hides the separators after it.
+func separators(s string, sep byte) []int {
+	var res []int
+	quoted := false
+	for k := 0; k < len(s); k++ {
+		switch c := s[k]; {
+		case quoted && c == '\\':
+			k++
+		case c == '"':
+			quoted = !quoted
+		case !quoted && c == sep:
+			res = append(res, k)
+		}
+	}
+	return res
+}
+
+// parseEndpoint parses an endpoint value, which may be quoted, or an infinity with given sign.
+func parseEndpoint[T Ordered[T]](s string, closed bool, sign string, parse func(string) (T, error)) (Endpoint[T], error) {
+	if strings.HasPrefix(s, `"`) {
+		v, err := strconv.Unquote(s)
+		if err != nil {
+			return Endpoint[T]{}, fmt.Errorf("%w: %s", ErrSyntax, s)
+		}
+		s = v
+	} else if inf := strings.TrimPrefix(s, sign); strings.EqualFold(inf, "inf") || inf == "∞" {
+		return UnboundedEp[T](), nil
+	}
+	v, err := parse(s)
+	if err != nil {
+		return Endpoint[T]{}, err
+	}
+	return Endpoint[T]{Value: v, Closed: closed}, nil
+}
@@ -0,0 +1,193 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func parseInt(s string) (Int, error) {
+	n, err := strconv.Atoi(s)
+	return Int(n), err
+}
+
+func TestIntervalFormat(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	cases := []struct {
+		name   string
+		format string
+		value  any
+		want   string
+	}{
+		{
+			name:   "closed-open",
+			format: "%v",
+			value:  New(ClosedEp(Int(1)), OpenEp(Int(3))),
+			want:   "[1, 3)",
+		},
+		{
+			name:   "unbounded",
+			format: "%v",
+			value:  New(unbounded, ClosedEp(Int(5))),
+			want:   "(-inf, 5]",
+		},
+		{
+			name:   "entire",
+			format: "%v",
+			value:  New(unbounded, unbounded),
+			want:   "(-inf, +inf)",
+		},
+		{
+			name:   "empty",
+			format: "%v",
+			value:  Interval[Int]{},
+			want:   "∅",
+		},
+		{
+			name:   "flags are applied to values",
+			format: "%+.2d",
+			value:  New(OpenEp(Int(1)), ClosedEp(Int(3))),
+			want:   "(+01, +03]",
+		},
+		{
+			name:   "width",
+			format: "[%10v]",
+			value:  Closed(Int(1), Int(3)),
+			want:   "[    [1, 3]]",
+		},
+		{
+			name:   "width, left-justified",
+			format: "[%-10.2d]",
+			value:  Closed(Int(1), Int(3)),
+			want:   "[[01, 03]  ]",
+		},
+		{
+			name:   "width, empty",
+			format: "%3v",
+			value:  Interval[Int]{},
+			want:   "  ∅",
+		},
+		{
+			name:   "width narrower than notation",
+			format: "%2v",
+			value:  Closed(Int(1), Int(3)),
+			want:   "[1, 3]",
+		},
+		{
+			name:   "go syntax",
+			format: "%#v",
+			value:  New(ClosedEp(Int(1)), unbounded),
+			want:   "interval.Interval[interval.Int]{Lower:interval.Endpoint[interval.Int]{Value:1, Closed:true, Unbounded:false}, Upper:interval.Endpoint[interval.Int]{Value:0, Closed:false, Unbounded:true}}",
+		},
+		{
+			name:   "time",
+			format: "%v",
+			value: New(
+				ClosedEp(Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))),
+				OpenEp(Time(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))),
+			),
+			want: "[2020-01-01T00:00:00Z, 2020-01-02T00:00:00Z)",
+		},
+		{
+			name:   "endpoint, closed",
+			format: "%v",
+			value:  ClosedEp(Int(1)),
+			want:   "closed 1",
+		},
+		{
+			name:   "endpoint, open",
+			format: "%.3d",
+			value:  OpenEp(Int(1)),
+			want:   "open 001",
+		},
+		{
+			name:   "endpoint, width",
+			format: "%-10v|",
+			value:  ClosedEp(Int(1)),
+			want:   "closed 1  |",
+		},
+		{
+			name:   "endpoint, unbounded",
+			format: "%v",
+			value:  unbounded,
+			want:   "unbounded",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, fmt.Sprintf(c.format, c.value))
+		})
+	}
+
+	assertEqual(t, "[1, 3)", New(ClosedEp(Int(1)), OpenEp(Int(3))).String())
+	assertEqual(t, "open 1", OpenEp(Int(1)).String())
+}
+
+func TestParse(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	cases := []struct {
+		input string
+		want  Interval[Int]
+	}{
+		{input: "[1, 3)", want: New(ClosedEp(Int(1)), OpenEp(Int(3)))},
+		{input: "(1,3]", want: New(OpenEp(Int(1)), ClosedEp(Int(3)))},
+		{input: " [ -1 , 3 ] ", want: New(ClosedEp(Int(-1)), ClosedEp(Int(3)))},
+		{input: "]1, 3[", want: New(OpenEp(Int(1)), OpenEp(Int(3)))},
+		{input: "[1; 3]", want: New(ClosedEp(Int(1)), ClosedEp(Int(3)))},
+		{input: "(-inf, 5]", want: New(unbounded, ClosedEp(Int(5)))},
+		{input: "(-∞, +∞)", want: New(unbounded, unbounded)},
+		{input: "(INF, inf)", want: New(unbounded, unbounded)},
+		{input: "[1, ∞)", want: New(ClosedEp(Int(1)), unbounded)},
+		{input: "∅", want: Interval[Int]{}},
+		{input: "{}", want: Interval[Int]{}},
+		{input: "empty", want: Interval[Int]{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			got, err := Parse(c.input, parseInt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, c.want, got)
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		i := New(OpenEp(Int(-2)), ClosedEp(Int(7)))
+		got, err := Parse(i.String(), parseInt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, i, got)
+	})
+}
+
+func TestParseError(t *testing.T) {
+	cases := []struct {
+		input  string
+		syntax bool
+	}{
+		{input: "", syntax: true},
+		{input: "1, 3", syntax: true},
+		{input: "[1, 3", syntax: true},
+		{input: "[1 3]", syntax: true},
+		{input: "[1, 2, 3]", syntax: true},
+		{input: "[+inf, 3]", syntax: false},
+		{input: "[1, -inf]", syntax: false},
+		{input: "[a, 3]", syntax: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			_, err := Parse(c.input, parseInt)
+			if err == nil {
+				t.Fatal("want error, got nil")
+			}
+			assertEqual(t, c.syntax, errors.Is(err, ErrSyntax))
+		})
+	}
+}
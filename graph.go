@@ -0,0 +1,123 @@
+package interval
+
+import "sort"
+
+// Graph is the interval graph of intervals,
+// where intervals are adjacent if they overlap as with Interval.Overlaps.
+// Vertices are identified by the indices of given intervals,
+// and empty intervals are isolated vertices.
+// Edges are not materialized, so queries run in O(n log n) time
+// besides the size of their results.
+type Graph[T Ordered[T]] struct {
+	intervals []Interval[T]
+	tree      Tree[T, int]
+}
+
+// NewGraph returns the interval graph of given intervals.
+func NewGraph[T Ordered[T]](intervals ...Interval[T]) *Graph[T] {
+	d := domainOf[T]()
+	g := &Graph[T]{intervals: make([]Interval[T], len(intervals))}
+	for k, i := range intervals {
+		if c := d.canonical(i); !c.isEmpty(d.compare) {
+			g.intervals[k] = c
+			g.tree.Insert(c, k)
+		}
+	}
+	return g
+}
+
+// Len returns the number of vertices of graph.
+func (g *Graph[T]) Len() int {
+	return len(g.intervals)
+}
+
+// Neighbors returns the vertices adjacent to vertex k in ascending order.
+func (g *Graph[T]) Neighbors(k int) []int {
+	var res []int
+	for _, e := range g.tree.Overlapping(g.intervals[k]) {
+		if e.Value != k {
+			res = append(res, e.Value)
+		}
+	}
+	sort.Ints(res)
+	return res
+}
+
+// Components returns the connected components of graph ordered by their lower endpoints.
+// Each component lists its vertices in ascending order.
+// Intervals only touching each other like [1, 3) and [3, 5] are in different components,
+// and each empty interval forms a component of its own following the others.
+func (g *Graph[T]) Components() [][]int {
+	compare := comparer[T]()
+	order, _ := nonEmpty(g.intervals)
+	sort.SliceStable(order, func(a, b int) bool {
+		return compare.ep(g.intervals[order[a]].Lower, false, g.intervals[order[b]].Lower, false) < 0
+	})
+
+	var res [][]int
+	var upper Endpoint[T]
+	for _, k := range order {
+		i := g.intervals[k]
+		if len(res) > 0 && compare.ep(upper, true, i.Lower, false) > 0 {
+			res[len(res)-1] = append(res[len(res)-1], k)
+			if compare.ep(i.Upper, true, upper, true) > 0 {
+				upper = i.Upper
+			}
+			continue
+		}
+		res = append(res, []int{k})
+		upper = i.Upper
+	}
+	for _, c := range res {
+		sort.Ints(c)
+	}
+	for k, i := range g.intervals {
+		if i.isEmpty(compare) {
+			res = append(res, []int{k})
+		}
+	}
+	return res
+}
+
+// MaxClique returns a largest set of pairwise adjacent vertices in ascending order,
+// and the interval where all of them overlap.
+// Of the largest cliques, the one overlapping at the lowest points is returned.
+// It returns nil and the empty interval if graph has no non-empty intervals.
+func (g *Graph[T]) MaxClique() ([]int, Interval[T]) {
+	_, at := MaxDepth(g.intervals)
+	if len(at) == 0 {
+		return nil, Interval[T]{}
+	}
+	// a segment of the same depth may change its intervals inside,
+	// so take the ones covering its start, and end where the first of them ends
+	compare := comparer[T]()
+	i := at[0]
+	var res []int
+	for _, e := range g.tree.Overlapping(i) {
+		if compare.ep(e.Interval.Lower, false, i.Lower, false) <= 0 {
+			res = append(res, e.Value)
+			if compare.ep(e.Interval.Upper, true, i.Upper, true) < 0 {
+				i.Upper = e.Interval.Upper
+			}
+		}
+	}
+	sort.Ints(res)
+	return res, i
+}
+
+// Coloring returns an optimal coloring of graph, where adjacent vertices have different colors,
+// and the number of colors, which equals the size of the largest clique.
+// Colors are numbered from 0, and empty intervals have color -1 as they need no color.
+func (g *Graph[T]) Coloring() ([]int, int) {
+	colors := make([]int, len(g.intervals))
+	for k := range colors {
+		colors[k] = -1
+	}
+	lanes := Partition(g.intervals)
+	for c, lane := range lanes {
+		for _, k := range lane {
+			colors[k] = c
+		}
+	}
+	return colors, len(lanes)
+}
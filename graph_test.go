@@ -0,0 +1,117 @@
+package interval
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestGraph(t *testing.T) {
+	f := func(v float64) Float64 { return Float64(v) }
+	g := NewGraph(
+		ClosedOpen(f(1), f(3)),
+		Closed(f(3), f(5)),
+		Open(f(2), f(4)),
+		Closed(f(4), f(6)),
+		Closed(f(8), f(9)),
+		Open(f(7), f(7)),
+		Closed(f(6), f(8)),
+	)
+	assertEqual(t, 7, g.Len())
+
+	assertDeepEqual(t, []int{2}, g.Neighbors(0))
+	assertDeepEqual(t, []int{2, 3}, g.Neighbors(1))
+	assertDeepEqual(t, []int{0, 1}, g.Neighbors(2))
+	assertDeepEqual(t, []int(nil), g.Neighbors(5))
+	assertDeepEqual(t, []int{3, 4}, g.Neighbors(6))
+
+	assertDeepEqual(t, [][]int{{0, 1, 2, 3, 4, 6}, {5}}, g.Components())
+	assertDeepEqual(t, [][]int{{0}, {1}, {2}}, NewGraph(ClosedOpen(f(1), f(3)), Closed(f(3), f(5)), Closed(f(6), f(7))).Components())
+
+	clique, at := g.MaxClique()
+	assertDeepEqual(t, []int{0, 2}, clique)
+	assertEqual(t, Open(f(2), f(3)), at)
+
+	colors, n := g.Coloring()
+	assertDeepEqual(t, []int{0, 0, 1, 1, 1, -1, 0}, colors)
+	assertEqual(t, 2, n)
+
+	empty := NewGraph[Int]()
+	clique2, at2 := empty.MaxClique()
+	assertDeepEqual(t, []int(nil), clique2)
+	assertEqual(t, true, at2.IsEmpty())
+	assertDeepEqual(t, [][]int(nil), empty.Components())
+}
+
+// TestGraphRandom compares the results with the graph of materialized edges.
+func TestGraphRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 100; n++ {
+		intervals := make([]Interval[Int], r.Intn(30))
+		for k := range intervals {
+			lower := Int(r.Intn(50))
+			intervals[k] = New(Endpoint[Int]{Value: lower, Closed: r.Intn(2) == 0}, Endpoint[Int]{Value: lower + Int(r.Intn(10)), Closed: r.Intn(2) == 0})
+		}
+		g := NewGraph(intervals...)
+
+		adjacent := make([][]int, len(intervals))
+		for k := range intervals {
+			for k2 := range intervals {
+				if k != k2 && intervals[k].Overlaps(intervals[k2]) {
+					adjacent[k] = append(adjacent[k], k2)
+				}
+			}
+			assertDeepEqual(t, adjacent[k], g.Neighbors(k))
+		}
+
+		// components by depth-first search
+		component := make([]int, len(intervals))
+		for k := range component {
+			component[k] = -1
+		}
+		count := 0
+		for k := range intervals {
+			if component[k] >= 0 {
+				continue
+			}
+			stack := []int{k}
+			component[k] = count
+			for len(stack) > 0 {
+				v := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				for _, v2 := range adjacent[v] {
+					if component[v2] < 0 {
+						component[v2] = count
+						stack = append(stack, v2)
+					}
+				}
+			}
+			count++
+		}
+		components := g.Components()
+		assertEqual(t, count, len(components))
+		for _, c := range components {
+			assertEqual(t, true, sort.IntsAreSorted(c))
+			for _, k := range c {
+				assertEqual(t, component[c[0]], component[k])
+			}
+		}
+
+		depth, _ := MaxDepth(intervals)
+		clique, at := g.MaxClique()
+		assertEqual(t, depth, len(clique))
+		for _, k := range clique {
+			assertEqual(t, true, intervals[k].Encloses(at))
+		}
+
+		colors, n := g.Coloring()
+		assertEqual(t, depth, n)
+		for k := range intervals {
+			for _, k2 := range adjacent[k] {
+				if colors[k] == colors[k2] {
+					t.Fatalf("%v and %v have the same color", intervals[k], intervals[k2])
+				}
+			}
+		}
+	}
+}
@@ -1,9 +1,20 @@
 package interval
 
-var _ Ordered[Int] = Int(0)
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+var (
+	_ Ordered[Int]  = Int(0)
+	_ Discrete[Int] = Int(0)
+)
 
 // Int is a wrapper of int.
-// It implements the Ordered interface.
+// It implements the Ordered and Discrete interfaces.
 type Int int
 
 // Equal checks if i is equal to i2.
@@ -15,3 +26,71 @@ func (i Int) Equal(i2 Int) bool {
 func (i Int) LessThan(i2 Int) bool {
 	return i < i2
 }
+
+// Next returns i+1. It returns false if i is the maximum int.
+func (i Int) Next() (Int, bool) {
+	if i == math.MaxInt {
+		return i, false
+	}
+	return i + 1, true
+}
+
+// Prev returns i-1. It returns false if i is the minimum int.
+func (i Int) Prev() (Int, bool) {
+	if i == math.MinInt {
+		return i, false
+	}
+	return i - 1, true
+}
+
+// Steps returns i2-i.
+func (i Int) Steps(i2 Int) uint64 {
+	return uint64(i2) - uint64(i)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (i Int) MarshalText() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(i), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *Int) UnmarshalText(text []byte) error {
+	n, err := strconv.Atoi(string(text))
+	if err != nil {
+		return err
+	}
+	*i = Int(n)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+// Int is encoded as a JSON number.
+func (i Int) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(i))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *Int) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, (*int)(i))
+}
+
+// Scan implements sql.Scanner.
+// It accepts integers and their text representations.
+func (i *Int) Scan(src any) error {
+	switch src := src.(type) {
+	case int64:
+		*i = Int(src)
+		return nil
+	case string:
+		return i.UnmarshalText([]byte(src))
+	case []byte:
+		return i.UnmarshalText(src)
+	default:
+		return fmt.Errorf("interval: cannot scan %T into Int", src)
+	}
+}
+
+// Value implements driver.Valuer.
+func (i Int) Value() (driver.Value, error) {
+	return int64(i), nil
+}
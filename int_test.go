@@ -1,6 +1,7 @@
 package interval
 
 import (
+	"math"
 	"testing"
 )
 
@@ -14,16 +15,66 @@ func TestInt(t *testing.T) {
 	t.Run("NewInterval", func(t *testing.T) {
 		testNewInterval(t, Int(1), Int(2))
 	})
+	t.Run("Constructors", func(t *testing.T) {
+		testConstructors(t, Int(1), Int(2))
+	})
+	t.Run("Validate", func(t *testing.T) {
+		testValidate(t, Int(1), Int(2))
+	})
 	t.Run("IsEmpty", func(t *testing.T) {
-		testIsEmpty(t, Int(1), Int(2))
+		testIsEmpty(t, Int(1), Int(3))
 	})
 	t.Run("IsEntire", func(t *testing.T) {
 		testIsEntire(t, Int(1))
 	})
 	t.Run("Contains", func(t *testing.T) {
-		testContains(t, Int(1), Int(2), Int(3))
+		testContains(t, Int(1), Int(3), Int(5))
 	})
 	t.Run("CompareInterval", func(t *testing.T) {
-		testCompareInterval(t, Int(1), Int(2), Int(3), Int(4))
+		testCompareInterval(t, Int(1), Int(3), Int(5), Int(7))
+	})
+	t.Run("Intersect", func(t *testing.T) {
+		testIntersect(t, Int(1), Int(3), Int(5), Int(7))
+	})
+	t.Run("Hull", func(t *testing.T) {
+		testHull(t, Int(1), Int(3), Int(5), Int(7))
+	})
+	t.Run("Difference", func(t *testing.T) {
+		testDifference(t, Int(1), Int(3), Int(5), Int(7))
+	})
+	t.Run("Relation", func(t *testing.T) {
+		testRelation(t, Int(1), Int(3), Int(5), Int(7))
+	})
+	t.Run("Predicates", func(t *testing.T) {
+		testPredicates(t, Int(1), Int(3), Int(5), Int(7))
+	})
+	t.Run("Gap", func(t *testing.T) {
+		testGap(t, Int(1), Int(3), Int(5), Int(7))
 	})
+	t.Run("Span", func(t *testing.T) {
+		testSpan(t, Int(1), Int(3), Int(5), Int(7))
+	})
+	t.Run("DepthProfile", func(t *testing.T) {
+		testDepthProfile(t, Int(1), Int(3), Int(5), Int(7))
+	})
+	t.Run("MaxDepth", func(t *testing.T) {
+		testMaxDepth(t, Int(1), Int(3), Int(5), Int(7))
+	})
+}
+
+func TestIntDiscrete(t *testing.T) {
+	n, ok := Int(1).Next()
+	assertEqual(t, Int(2), n)
+	assertEqual(t, true, ok)
+	_, ok = Int(math.MaxInt).Next()
+	assertEqual(t, false, ok)
+
+	p, ok := Int(1).Prev()
+	assertEqual(t, Int(0), p)
+	assertEqual(t, true, ok)
+	_, ok = Int(math.MinInt).Prev()
+	assertEqual(t, false, ok)
+
+	assertEqual(t, uint64(3), Int(-1).Steps(Int(2)))
+	assertEqual(t, uint64(math.MaxUint64), Int(math.MinInt).Steps(Int(math.MaxInt)))
 }
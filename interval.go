@@ -1,6 +1,11 @@
 // Package interval provides generic interval types and operations.
 package interval
 
+import (
+	"errors"
+	"fmt"
+)
+
 // Interval represents a interval consisting of two endpoints.
 // The zero value of Interval is an empty interval.
 type Interval[T Ordered[T]] struct {
@@ -16,15 +21,99 @@ func New[T Ordered[T]](lower, upper Endpoint[T]) Interval[T] {
 	}
 }
 
+// ErrInverted indicates that the lower endpoint of an interval is greater than the upper endpoint.
+var ErrInverted = errors.New("interval: inverted endpoints")
+
+// NewChecked returns an interval with given endpoints like New,
+// or an error if Validate reports one.
+func NewChecked[T Ordered[T]](lower, upper Endpoint[T]) (Interval[T], error) {
+	i := New(lower, upper)
+	if err := i.Validate(); err != nil {
+		return Interval[T]{}, err
+	}
+	return i, nil
+}
+
+// Validate returns ErrInconsistentEndpoint if an endpoint is both closed and unbounded,
+// and ErrInverted if the value of the lower endpoint is greater than that of the upper endpoint.
+// Intervals with equal values such as [1, 1) are valid though they are empty.
+func (i Interval[T]) Validate() error {
+	if (i.Lower.Closed && i.Lower.Unbounded) || (i.Upper.Closed && i.Upper.Unbounded) {
+		return ErrInconsistentEndpoint
+	}
+	if i.Lower.Bounded() && i.Upper.Bounded() && comparer[T]().cmp(i.Lower.Value, i.Upper.Value) > 0 {
+		return fmt.Errorf("%w: %v > %v", ErrInverted, i.Lower.Value, i.Upper.Value)
+	}
+	return nil
+}
+
+// Closed returns the interval [lower, upper].
+func Closed[T Ordered[T]](lower, upper T) Interval[T] {
+	return New(ClosedEp(lower), ClosedEp(upper))
+}
+
+// Open returns the interval (lower, upper).
+func Open[T Ordered[T]](lower, upper T) Interval[T] {
+	return New(OpenEp(lower), OpenEp(upper))
+}
+
+// ClosedOpen returns the interval [lower, upper).
+func ClosedOpen[T Ordered[T]](lower, upper T) Interval[T] {
+	return New(ClosedEp(lower), OpenEp(upper))
+}
+
+// OpenClosed returns the interval (lower, upper].
+func OpenClosed[T Ordered[T]](lower, upper T) Interval[T] {
+	return New(OpenEp(lower), ClosedEp(upper))
+}
+
+// AtLeast returns the interval [lower, +inf).
+func AtLeast[T Ordered[T]](lower T) Interval[T] {
+	return New(ClosedEp(lower), UnboundedEp[T]())
+}
+
+// AtMost returns the interval (-inf, upper].
+func AtMost[T Ordered[T]](upper T) Interval[T] {
+	return New(UnboundedEp[T](), ClosedEp(upper))
+}
+
+// GreaterThan returns the interval (lower, +inf).
+func GreaterThan[T Ordered[T]](lower T) Interval[T] {
+	return New(OpenEp(lower), UnboundedEp[T]())
+}
+
+// LessThan returns the interval (-inf, upper).
+func LessThan[T Ordered[T]](upper T) Interval[T] {
+	return New(UnboundedEp[T](), OpenEp(upper))
+}
+
+// Singleton returns the interval [v, v] containing only v.
+func Singleton[T Ordered[T]](v T) Interval[T] {
+	return Closed(v, v)
+}
+
+// Empty returns an empty interval, which is the zero value of Interval.
+func Empty[T Ordered[T]]() Interval[T] {
+	return Interval[T]{}
+}
+
+// Entire returns the interval (-inf, +inf) containing all values.
+func Entire[T Ordered[T]]() Interval[T] {
+	return New(UnboundedEp[T](), UnboundedEp[T]())
+}
+
 // IsEmpty returns true if no points are contained in interval.
 func (i Interval[T]) IsEmpty() bool {
+	return domainOf[T]().empty(i)
+}
+
+// isEmpty is IsEmpty without the canonicalization of discrete intervals.
+func (i Interval[T]) isEmpty(compare comparator[T]) bool {
 	if i.Lower.Unbounded || i.Upper.Unbounded {
 		return false
 	}
-	if i.Lower.Value.LessThan(i.Upper.Value) {
-		return false
-	}
-	return !i.Lower.equalAndBothClosed(i.Upper)
+	c := compare.cmp(i.Lower.Value, i.Upper.Value)
+	return c > 0 || (c == 0 && (!i.Lower.Closed || !i.Upper.Closed))
 }
 
 // IsEntire returns true if both endpoints are unbounded.
@@ -33,46 +122,228 @@ func (i Interval[T]) IsEntire() bool {
 }
 
 // Contains returns true if interval contains the point with given value.
+// NaN is contained in no interval, although the floating-point types order it below any other value.
 func (i Interval[T]) Contains(p T) bool {
-	if i.IsEmpty() {
+	if isNaN(p) {
 		return false
 	}
-	if i.Lower.Bounded() && (p.LessThan(i.Lower.Value) || (p.Equal(i.Lower.Value) && !i.Lower.Closed)) {
-		return false
+	// the point lying between the endpoints is contained, so no emptiness check is needed
+	compare := comparer[T]()
+	if i.Lower.Bounded() {
+		if c := compare.cmp(p, i.Lower.Value); c < 0 || (c == 0 && !i.Lower.Closed) {
+			return false
+		}
 	}
-	if i.Upper.Bounded() && (i.Upper.Value.LessThan(p) || (p.Equal(i.Upper.Value) && !i.Upper.Closed)) {
-		return false
+	if i.Upper.Bounded() {
+		if c := compare.cmp(i.Upper.Value, p); c < 0 || (c == 0 && !i.Upper.Closed) {
+			return false
+		}
 	}
 	return true
 }
 
 // Before returns true if interval ends before other interval starts.
 func (i Interval[T]) Before(i2 Interval[T]) bool {
-	if i.IsEmpty() || i2.IsEmpty() {
-		return false
-	}
-	if i.Upper.Bounded() && i2.Lower.Bounded() {
-		return i.Upper.Value.LessThan(i2.Lower.Value) || (i.Upper.Value.Equal(i2.Lower.Value) && (!i.Upper.Closed || !i2.Lower.Closed))
-	}
-	return false
+	d := domainOf[T]()
+	return d.closedOpen(i).before(d.closedOpen(i2), d.compare)
 }
 
 // After returns true if interval starts after other interval ends.
 func (i Interval[T]) After(i2 Interval[T]) bool {
-	if i.IsEmpty() || i2.IsEmpty() {
+	d := domainOf[T]()
+	return d.closedOpen(i2).before(d.closedOpen(i), d.compare)
+}
+
+// before is Before of canonical intervals.
+func (i Interval[T]) before(i2 Interval[T], compare comparator[T]) bool {
+	if i.isEmpty(compare) || i2.isEmpty(compare) {
 		return false
 	}
-	if i2.Upper.Bounded() && i.Lower.Bounded() {
-		return i2.Upper.Value.LessThan(i.Lower.Value) || (i2.Upper.Value.Equal(i.Lower.Value) && (!i2.Upper.Closed || !i.Lower.Closed))
-	}
-	return false
+	// no point lies on both sides of the cut between them
+	return compare.ep(i.Upper, true, i2.Lower, false) <= 0
 }
 
 // Overlap returns true if interval shares at least one point with other interval.
 func (i Interval[T]) Overlaps(i2 Interval[T]) bool {
+	d := domainOf[T]()
+	return d.closedOpen(i).overlaps(d.closedOpen(i2), d.compare)
+}
+
+// overlaps is Overlaps of canonical intervals.
+func (i Interval[T]) overlaps(i2 Interval[T], compare comparator[T]) bool {
 	// empty interval never overlaps
-	if i.IsEmpty() || i2.IsEmpty() {
+	if i.isEmpty(compare) || i2.isEmpty(compare) {
 		return false
 	}
-	return !i.Before(i2) && !i.After(i2)
+	return compare.ep(i.Upper, true, i2.Lower, false) > 0 &&
+		compare.ep(i2.Upper, true, i.Lower, false) > 0
+}
+
+// IsConnected returns true if the union of non-empty intervals is an interval,
+// that is, they share a point or touch like [1, 3) and [3, 5].
+// Discrete intervals are compared in their canonical forms, so [1, 2] and [3, 4] are connected for Int.
+func (i Interval[T]) IsConnected(i2 Interval[T]) bool {
+	d := domainOf[T]()
+	return d.closedOpen(i).connected(d.closedOpen(i2), d.compare)
+}
+
+// connected is IsConnected of canonical intervals.
+func (i Interval[T]) connected(i2 Interval[T], compare comparator[T]) bool {
+	if i.isEmpty(compare) || i2.isEmpty(compare) {
+		return false
+	}
+	return compare.ep(i.Upper, true, i2.Lower, false) >= 0 &&
+		compare.ep(i2.Upper, true, i.Lower, false) >= 0
+}
+
+// Adjacent returns true if non-empty intervals touch each other without sharing a point,
+// like [1, 3) and [3, 5].
+func (i Interval[T]) Adjacent(i2 Interval[T]) bool {
+	r := i.Relation(i2)
+	return r == AllenMeets || r == AllenMetBy
+}
+
+// Encloses returns true if interval contains every point of other interval.
+// Any interval encloses empty intervals, and empty intervals enclose only empty intervals.
+func (i Interval[T]) Encloses(i2 Interval[T]) bool {
+	d := domainOf[T]()
+	c, c2 := d.canonical(i), d.canonical(i2)
+	if c2.isEmpty(d.compare) {
+		return true
+	}
+	if c.isEmpty(d.compare) {
+		return false
+	}
+	return d.compare.ep(c.Lower, false, c2.Lower, false) <= 0 &&
+		d.compare.ep(c.Upper, true, c2.Upper, true) >= 0
+}
+
+// Intersect returns the interval of points contained in both intervals.
+// If they share no point, the zero value (an empty interval) is returned.
+func (i Interval[T]) Intersect(i2 Interval[T]) Interval[T] {
+	return i.intersect(i2, domainOf[T]())
+}
+
+func (i Interval[T]) intersect(i2 Interval[T], d domain[T]) Interval[T] {
+	if !d.canonical(i).overlaps(d.canonical(i2), d.compare) {
+		return Interval[T]{}
+	}
+	lower, upper := i.Lower, i.Upper
+	if d.compare.ep(i2.Lower, false, lower, false) > 0 {
+		lower = i2.Lower
+	}
+	if d.compare.ep(i2.Upper, true, upper, true) < 0 {
+		upper = i2.Upper
+	}
+	return New(lower, upper)
+}
+
+// Hull returns the smallest interval containing both intervals.
+// Empty intervals are ignored.
+func (i Interval[T]) Hull(i2 Interval[T]) Interval[T] {
+	return i.hull(i2, domainOf[T]())
+}
+
+func (i Interval[T]) hull(i2 Interval[T], d domain[T]) Interval[T] {
+	if d.empty(i) {
+		return i2
+	}
+	if d.empty(i2) {
+		return i
+	}
+	lower, upper := i.Lower, i.Upper
+	if d.compare.ep(i2.Lower, false, lower, false) < 0 {
+		lower = i2.Lower
+	}
+	if d.compare.ep(i2.Upper, true, upper, true) > 0 {
+		upper = i2.Upper
+	}
+	return New(lower, upper)
+}
+
+// Span returns the smallest interval containing all of intervals.
+// Empty intervals are ignored, and the zero value (an empty interval) is returned
+// if no interval is given.
+func Span[T Ordered[T]](intervals ...Interval[T]) Interval[T] {
+	d := domainOf[T]()
+	var res Interval[T]
+	for _, i := range intervals {
+		if !d.empty(i) {
+			res = res.hull(i, d)
+		}
+	}
+	return res
+}
+
+// Gap returns the interval of points lying between the intervals.
+// It returns the zero value (an empty interval) if they overlap or touch each other,
+// or either is empty.
+func (i Interval[T]) Gap(i2 Interval[T]) Interval[T] {
+	d := domainOf[T]()
+	c, c2 := d.canonical(i), d.canonical(i2)
+	if c.isEmpty(d.compare) || c2.isEmpty(d.compare) {
+		return Interval[T]{}
+	}
+	if c2.before(c, d.compare) {
+		i, i2, c, c2 = i2, i, c2, c
+	}
+	if g := New(i.Upper.flip(), i2.Lower.flip()); c.before(c2, d.compare) && !d.empty(g) {
+		return g
+	}
+	return Interval[T]{}
+}
+
+// Clamp returns the point of interval nearest to the point with given value,
+// which is the value itself if interval contains it.
+// It returns false if interval is empty, the value is NaN or the nearest endpoint is open,
+// except that the neighbor of an open endpoint is returned if T implements Discrete.
+func (i Interval[T]) Clamp(p T) (T, bool) {
+	var zero T
+	d := domainOf[T]()
+	switch {
+	case d.empty(i) || isNaN(p):
+		return zero, false
+	case i.Contains(p):
+		return p, true
+	}
+	// p lies below the lower endpoint or above the upper endpoint
+	below := i.Lower.Bounded() && d.compare.cmp(p, i.Lower.Value) <= 0
+	e := i.Upper
+	if below {
+		e = i.Lower
+	}
+	if e.Closed {
+		return e.Value, true
+	}
+	if d.next != nil {
+		n, ok := d.prev(e.Value)
+		if below {
+			n, ok = d.next(e.Value)
+		}
+		if ok && i.Contains(n) {
+			return n, true
+		}
+	}
+	return zero, false
+}
+
+// Difference returns the points of interval not contained in other interval.
+// The result consists of zero, one or two non-empty intervals in ascending order.
+func (i Interval[T]) Difference(i2 Interval[T]) []Interval[T] {
+	d := domainOf[T]()
+	c := d.canonical(i)
+	if c.isEmpty(d.compare) {
+		return nil
+	}
+	if !c.overlaps(d.canonical(i2), d.compare) {
+		return []Interval[T]{i}
+	}
+	var res []Interval[T]
+	if left := New(i.Lower, i2.Lower.flip()); i2.Lower.Bounded() && !d.empty(left) {
+		res = append(res, left)
+	}
+	if right := New(i2.Upper.flip(), i.Upper); i2.Upper.Bounded() && !d.empty(right) {
+		res = append(res, right)
+	}
+	return res
 }
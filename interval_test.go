@@ -1,6 +1,10 @@
 package interval
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
 
 func testNewInterval[T Ordered[T]](t *testing.T, v1, v2 T) {
 	assertEqual(t, Interval[T]{
@@ -9,6 +13,79 @@ func testNewInterval[T Ordered[T]](t *testing.T, v1, v2 T) {
 	}, New(OpenEp(v1), OpenEp(v2)))
 }
 
+func testConstructors[T Ordered[T]](t *testing.T, v1, v2 T) {
+	unbounded := UnboundedEp[T]()
+	cases := []struct {
+		name string
+		got  Interval[T]
+		want Interval[T]
+	}{
+		{"Closed", Closed(v1, v2), New(ClosedEp(v1), ClosedEp(v2))},
+		{"Open", Open(v1, v2), New(OpenEp(v1), OpenEp(v2))},
+		{"ClosedOpen", ClosedOpen(v1, v2), New(ClosedEp(v1), OpenEp(v2))},
+		{"OpenClosed", OpenClosed(v1, v2), New(OpenEp(v1), ClosedEp(v2))},
+		{"AtLeast", AtLeast(v1), New(ClosedEp(v1), unbounded)},
+		{"AtMost", AtMost(v2), New(unbounded, ClosedEp(v2))},
+		{"GreaterThan", GreaterThan(v1), New(OpenEp(v1), unbounded)},
+		{"LessThan", LessThan(v2), New(unbounded, OpenEp(v2))},
+		{"Singleton", Singleton(v1), New(ClosedEp(v1), ClosedEp(v1))},
+		{"Empty", Empty[T](), Interval[T]{}},
+		{"Entire", Entire[T](), New(unbounded, unbounded)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, c.got)
+		})
+	}
+	assertEqual(t, true, Empty[T]().IsEmpty())
+	assertEqual(t, true, Entire[T]().IsEntire())
+}
+
+func testValidate[T Ordered[T]](t *testing.T, v1, v2 T) {
+	if !v1.LessThan(v2) {
+		t.Fatalf("v1 must be less than v2. v1: %v, v2: %v", v1, v2)
+	}
+
+	unbounded := UnboundedEp[T]()
+	inconsistent := Endpoint[T]{Closed: true, Unbounded: true}
+	cases := []struct {
+		name         string
+		lower, upper Endpoint[T]
+		err          error
+	}{
+		{"valid", ClosedEp(v1), OpenEp(v2), nil},
+		{"equal values", OpenEp(v1), OpenEp(v1), nil},
+		{"zero value", Endpoint[T]{}, Endpoint[T]{}, nil},
+		{"unbounded", unbounded, unbounded, nil},
+		{"inverted", ClosedEp(v2), ClosedEp(v1), ErrInverted},
+		{"inconsistent lower", inconsistent, ClosedEp(v1), ErrInconsistentEndpoint},
+		{"inconsistent upper", ClosedEp(v1), inconsistent, ErrInconsistentEndpoint},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := New(c.lower, c.upper).Validate()
+			if !errors.Is(err, c.err) {
+				t.Fatalf("want error %v, got %v", c.err, err)
+			}
+
+			i, err := NewChecked(c.lower, c.upper)
+			if !errors.Is(err, c.err) {
+				t.Fatalf("want error %v, got %v", c.err, err)
+			}
+			if err == nil {
+				assertEqual(t, New(c.lower, c.upper), i)
+			} else {
+				assertEqual(t, Interval[T]{}, i)
+			}
+		})
+	}
+
+	err := New(OpenEp(v2), ClosedEp(v1)).Validate()
+	assertEqual(t, fmt.Sprintf("%v: %v > %v", ErrInverted, v2, v1), err.Error())
+}
+
 func testIsEmpty[T Ordered[T]](t *testing.T, v1, v2 T) {
 	if !v1.LessThan(v2) {
 		t.Fatalf("v1 must be less than v2. v1: %v, v2: %v", v1, v2)
@@ -624,3 +701,326 @@ func testCompareInterval[T Ordered[T]](t *testing.T, v1, v2, v3, v4 T) {
 		})
 	}
 }
+
+func testIntersect[T Ordered[T]](t *testing.T, v1, v2, v3, v4 T) {
+	if !(v1.LessThan(v2) && v2.LessThan(v3) && v3.LessThan(v4)) {
+		t.Fatalf("must be v1 < v2 < v3 < v4. got v1=%v, v2=%v, v3=%v, v4=%v", v1, v2, v3, v4)
+	}
+
+	unbounded := UnboundedEp[T]()
+	cases := []struct {
+		name string
+		i    Interval[T]
+		i2   Interval[T]
+		want Interval[T]
+	}{
+		{
+			name: "i is empty",
+			i:    New(OpenEp(v1), OpenEp(v1)),
+			i2:   New(unbounded, unbounded),
+			want: Interval[T]{},
+		},
+		{
+			name: "disjoint",
+			i:    New(OpenEp(v1), OpenEp(v2)),
+			i2:   New(OpenEp(v3), OpenEp(v4)),
+			want: Interval[T]{},
+		},
+		{
+			name: "touching, no contact",
+			i:    New(OpenEp(v1), OpenEp(v2)),
+			i2:   New(ClosedEp(v2), OpenEp(v3)),
+			want: Interval[T]{},
+		},
+		{
+			name: "touching, contact",
+			i:    New(OpenEp(v1), ClosedEp(v2)),
+			i2:   New(ClosedEp(v2), OpenEp(v3)),
+			want: New(ClosedEp(v2), ClosedEp(v2)),
+		},
+		{
+			name: "partially overlapping",
+			i:    New(ClosedEp(v1), OpenEp(v3)),
+			i2:   New(OpenEp(v2), ClosedEp(v4)),
+			want: New(OpenEp(v2), OpenEp(v3)),
+		},
+		{
+			name: "same values, open wins",
+			i:    New(ClosedEp(v1), ClosedEp(v3)),
+			i2:   New(OpenEp(v1), OpenEp(v3)),
+			want: New(OpenEp(v1), OpenEp(v3)),
+		},
+		{
+			name: "unbounded",
+			i:    New(unbounded, OpenEp(v3)),
+			i2:   New(ClosedEp(v2), unbounded),
+			want: New(ClosedEp(v2), OpenEp(v3)),
+		},
+		{
+			name: "both entire",
+			i:    New(unbounded, unbounded),
+			i2:   New(unbounded, unbounded),
+			want: New(unbounded, unbounded),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, c.i.Intersect(c.i2))
+			assertEqual(t, c.want, c.i2.Intersect(c.i))
+		})
+	}
+}
+
+func testHull[T Ordered[T]](t *testing.T, v1, v2, v3, v4 T) {
+	if !(v1.LessThan(v2) && v2.LessThan(v3) && v3.LessThan(v4)) {
+		t.Fatalf("must be v1 < v2 < v3 < v4. got v1=%v, v2=%v, v3=%v, v4=%v", v1, v2, v3, v4)
+	}
+
+	unbounded := UnboundedEp[T]()
+	cases := []struct {
+		name string
+		i    Interval[T]
+		i2   Interval[T]
+		want Interval[T]
+	}{
+		{
+			name: "both are empty",
+			i:    Interval[T]{},
+			i2:   Interval[T]{},
+			want: Interval[T]{},
+		},
+		{
+			name: "i is empty",
+			i:    New(OpenEp(v4), OpenEp(v1)),
+			i2:   New(OpenEp(v2), OpenEp(v3)),
+			want: New(OpenEp(v2), OpenEp(v3)),
+		},
+		{
+			name: "disjoint",
+			i:    New(OpenEp(v1), OpenEp(v2)),
+			i2:   New(OpenEp(v3), ClosedEp(v4)),
+			want: New(OpenEp(v1), ClosedEp(v4)),
+		},
+		{
+			name: "same values, closed wins",
+			i:    New(ClosedEp(v1), OpenEp(v3)),
+			i2:   New(OpenEp(v1), ClosedEp(v3)),
+			want: New(ClosedEp(v1), ClosedEp(v3)),
+		},
+		{
+			name: "unbounded",
+			i:    New(unbounded, OpenEp(v2)),
+			i2:   New(OpenEp(v3), OpenEp(v4)),
+			want: New(unbounded, OpenEp(v4)),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, c.i.Hull(c.i2))
+			assertEqual(t, c.want, c.i2.Hull(c.i))
+		})
+	}
+}
+
+func testDifference[T Ordered[T]](t *testing.T, v1, v2, v3, v4 T) {
+	if !(v1.LessThan(v2) && v2.LessThan(v3) && v3.LessThan(v4)) {
+		t.Fatalf("must be v1 < v2 < v3 < v4. got v1=%v, v2=%v, v3=%v, v4=%v", v1, v2, v3, v4)
+	}
+
+	unbounded := UnboundedEp[T]()
+	cases := []struct {
+		name string
+		i    Interval[T]
+		i2   Interval[T]
+		want []Interval[T]
+	}{
+		{
+			name: "i is empty",
+			i:    New(OpenEp(v1), OpenEp(v1)),
+			i2:   New(OpenEp(v1), OpenEp(v2)),
+			want: nil,
+		},
+		{
+			name: "i2 is empty",
+			i:    New(OpenEp(v1), OpenEp(v2)),
+			i2:   New(OpenEp(v1), OpenEp(v1)),
+			want: []Interval[T]{New(OpenEp(v1), OpenEp(v2))},
+		},
+		{
+			name: "disjoint",
+			i:    New(OpenEp(v1), ClosedEp(v2)),
+			i2:   New(OpenEp(v2), OpenEp(v3)),
+			want: []Interval[T]{New(OpenEp(v1), ClosedEp(v2))},
+		},
+		{
+			name: "i2 covers i",
+			i:    New(OpenEp(v2), OpenEp(v3)),
+			i2:   New(unbounded, ClosedEp(v3)),
+			want: nil,
+		},
+		{
+			name: "cut lower part",
+			i:    New(ClosedEp(v1), ClosedEp(v3)),
+			i2:   New(unbounded, OpenEp(v2)),
+			want: []Interval[T]{New(ClosedEp(v2), ClosedEp(v3))},
+		},
+		{
+			name: "cut upper part",
+			i:    New(ClosedEp(v1), unbounded),
+			i2:   New(ClosedEp(v2), OpenEp(v3)),
+			want: []Interval[T]{
+				New(ClosedEp(v1), OpenEp(v2)),
+				New(ClosedEp(v3), unbounded),
+			},
+		},
+		{
+			name: "split into two",
+			i:    New(ClosedEp(v1), ClosedEp(v4)),
+			i2:   New(OpenEp(v2), ClosedEp(v3)),
+			want: []Interval[T]{
+				New(ClosedEp(v1), ClosedEp(v2)),
+				New(OpenEp(v3), ClosedEp(v4)),
+			},
+		},
+		{
+			name: "remove a single point",
+			i:    New(ClosedEp(v1), ClosedEp(v2)),
+			i2:   New(ClosedEp(v2), ClosedEp(v2)),
+			want: []Interval[T]{New(ClosedEp(v1), OpenEp(v2))},
+		},
+		{
+			name: "same values, open remainder is empty",
+			i:    New(ClosedEp(v1), ClosedEp(v2)),
+			i2:   New(OpenEp(v1), OpenEp(v2)),
+			want: []Interval[T]{
+				New(ClosedEp(v1), ClosedEp(v1)),
+				New(ClosedEp(v2), ClosedEp(v2)),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertDeepEqual(t, c.want, c.i.Difference(c.i2))
+		})
+	}
+}
+
+func testPredicates[T Ordered[T]](t *testing.T, v1, v2, v3, v4 T) {
+	empty := Interval[T]{}
+	cases := []struct {
+		name                                 string
+		i, i2                                Interval[T]
+		equal, encloses, connected, adjacent bool
+	}{
+		{"same", Closed(v1, v3), Closed(v1, v3), true, true, true, false},
+		{"both empty", Open(v2, v2), empty, true, true, false, false},
+		{"empty", Closed(v1, v3), empty, false, true, false, false},
+		{"enclosed by empty", empty, Closed(v1, v3), false, false, false, false},
+		{"enclosing", Closed(v1, v4), Open(v2, v3), false, true, true, false},
+		{"enclosed", Open(v2, v3), Closed(v1, v4), false, false, true, false},
+		{"same values, one open", Closed(v1, v3), ClosedOpen(v1, v3), false, true, true, false},
+		{"overlapping", Closed(v1, v3), Closed(v2, v4), false, false, true, false},
+		{"sharing an endpoint", Closed(v1, v2), Closed(v2, v3), false, false, true, false},
+		{"meeting", ClosedOpen(v1, v2), Closed(v2, v3), false, false, true, true},
+		{"met by", OpenClosed(v2, v3), Closed(v1, v2), false, false, true, true},
+		{"apart", Closed(v1, v2), Closed(v3, v4), false, false, false, false},
+		{"both open at a point", Open(v1, v2), Open(v2, v3), false, false, false, false},
+		{"unbounded", AtLeast(v1), GreaterThan(v2), false, true, true, false},
+		{"entire", Entire[T](), Closed(v1, v4), false, true, true, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.equal, c.i.Equal(c.i2))
+			assertEqual(t, c.encloses, c.i.Encloses(c.i2))
+			assertEqual(t, c.connected, c.i.IsConnected(c.i2))
+			assertEqual(t, c.adjacent, c.i.Adjacent(c.i2))
+			// symmetric ones
+			assertEqual(t, c.equal, c.i2.Equal(c.i))
+			assertEqual(t, c.connected, c.i2.IsConnected(c.i))
+			assertEqual(t, c.adjacent, c.i2.Adjacent(c.i))
+		})
+	}
+}
+
+func testGap[T Ordered[T]](t *testing.T, v1, v2, v3, v4 T) {
+	cases := []struct {
+		name  string
+		i, i2 Interval[T]
+		want  Interval[T]
+	}{
+		{"closed", Closed(v1, v2), Closed(v3, v4), Open(v2, v3)},
+		{"open", ClosedOpen(v1, v2), OpenClosed(v3, v4), Closed(v2, v3)},
+		{"reversed", Closed(v3, v4), Closed(v1, v2), Open(v2, v3)},
+		{"unbounded", AtMost(v1), AtLeast(v4), Open(v1, v4)},
+		{"overlapping", Closed(v1, v3), Closed(v2, v4), Interval[T]{}},
+		{"touching", ClosedOpen(v1, v2), Closed(v2, v3), Interval[T]{}},
+		{"empty", Closed(v1, v2), Interval[T]{}, Interval[T]{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, c.i.Gap(c.i2))
+		})
+	}
+}
+
+func testSpan[T Ordered[T]](t *testing.T, v1, v2, v3, v4 T) {
+	assertEqual(t, Interval[T]{}, Span[T]())
+	assertEqual(t, Interval[T]{}, Span(Interval[T]{}, Open(v1, v1)))
+	assertEqual(t, Closed(v2, v3), Span(Closed(v2, v3)))
+	assertEqual(t, ClosedOpen(v1, v4), Span(Closed(v2, v3), Interval[T]{}, ClosedOpen(v3, v4), Closed(v1, v2)))
+	assertEqual(t, AtMost(v4), Span(Closed(v2, v4), LessThan(v1)))
+}
+
+func TestClamp(t *testing.T) {
+	cases := []struct {
+		name string
+		i    Interval[Float64]
+		p    Float64
+		want Float64
+		ok   bool
+	}{
+		{"inside", Closed(Float64(1), Float64(3)), 2, 2, true},
+		{"below closed", Closed(Float64(1), Float64(3)), 0, 1, true},
+		{"above closed", Closed(Float64(1), Float64(3)), 4, 3, true},
+		{"below open", Open(Float64(1), Float64(3)), 1, 0, false},
+		{"above open", Open(Float64(1), Float64(3)), 4, 0, false},
+		{"unbounded", AtLeast(Float64(1)), 1e300, 1e300, true},
+		{"empty", Open(Float64(1), Float64(1)), 1, 0, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := c.i.Clamp(c.p)
+			assertEqual(t, c.want, got)
+			assertEqual(t, c.ok, ok)
+		})
+	}
+}
+
+func TestClampDiscrete(t *testing.T) {
+	cases := []struct {
+		name string
+		i    Interval[Int]
+		p    Int
+		want Int
+		ok   bool
+	}{
+		{"below open", Open(Int(1), Int(5)), 0, 2, true},
+		{"above open", Open(Int(1), Int(5)), 9, 4, true},
+		{"at open", Open(Int(1), Int(5)), 5, 4, true},
+		{"empty", Open(Int(1), Int(2)), 0, 0, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, ok := c.i.Clamp(c.p)
+			assertEqual(t, c.want, got)
+			assertEqual(t, c.ok, ok)
+		})
+	}
+}
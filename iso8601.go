@@ -0,0 +1,372 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period is a calendar-aware duration of ISO 8601, like P1Y2M10DT2H30M.
+// Years, months and days are added on the calendar,
+// and the time part is held as an exact time.Duration.
+// Weeks are held as 7 days.
+type Period struct {
+	Years    int
+	Months   int
+	Days     int
+	Duration time.Duration
+}
+
+// ParsePeriod parses an ISO 8601 duration like P1Y2M10DT2H30M, P2W or PT0.5S.
+// Only the time part may have fractions.
+// Negative periods like -P1D and negative components like P1MT-1H are accepted as in ISO 8601-2.
+func ParsePeriod(s string) (Period, error) {
+	if neg, ok := strings.CutPrefix(s, "-"); ok {
+		p, err := ParsePeriod(neg)
+		if err != nil || strings.HasPrefix(neg, "-") {
+			return Period{}, fmt.Errorf("%w: %q", ErrSyntax, s)
+		}
+		return p.mul(-1), nil
+	}
+	rest := strings.TrimPrefix(s, "P")
+	if rest == s || rest == "" || strings.HasSuffix(rest, "T") {
+		return Period{}, fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+	date, clock, _ := strings.Cut(rest, "T")
+
+	var p Period
+	for date != "" {
+		n, d, r, err := periodComponent(date)
+		if err != nil || strings.ContainsAny(n, ".,") {
+			return Period{}, fmt.Errorf("%w: %q", ErrSyntax, s)
+		}
+		v, err := strconv.Atoi(n)
+		if err != nil {
+			return Period{}, fmt.Errorf("interval: parsing %q: %w", s, err)
+		}
+		switch d {
+		case 'Y':
+			p.Years += v
+		case 'M':
+			p.Months += v
+		case 'W':
+			p.Days += 7 * v
+		case 'D':
+			p.Days += v
+		default:
+			return Period{}, fmt.Errorf("%w: %q", ErrSyntax, s)
+		}
+		date = r
+	}
+	for clock != "" {
+		n, d, r, err := periodComponent(clock)
+		if err != nil {
+			return Period{}, fmt.Errorf("%w: %q", ErrSyntax, s)
+		}
+		var unit string
+		switch d {
+		case 'H':
+			unit = "h"
+		case 'M':
+			unit = "m"
+		case 'S':
+			unit = "s"
+		default:
+			return Period{}, fmt.Errorf("%w: %q", ErrSyntax, s)
+		}
+		v, err := time.ParseDuration(strings.Replace(n, ",", ".", 1) + unit)
+		if err != nil {
+			return Period{}, fmt.Errorf("interval: parsing %q: %w", s, err)
+		}
+		p.Duration += v
+		clock = r
+	}
+	return p, nil
+}
+
+// periodComponent splits s into the leading number with an optional minus sign, its designator and the rest.
+func periodComponent(s string) (n string, designator byte, rest string, err error) {
+	sign := 0
+	if strings.HasPrefix(s, "-") {
+		sign = 1
+	}
+	k := strings.IndexFunc(s[sign:], func(r rune) bool {
+		return (r < '0' || '9' < r) && r != '.' && r != ','
+	})
+	if k <= 0 {
+		return "", 0, "", ErrSyntax
+	}
+	k += sign
+	return s[:k], s[k], s[k+1:], nil
+}
+
+// String returns period in ISO 8601 format, like P1Y2M10DT2H30M.
+// The zero Period is PT0S.
+// A period without positive components is written with a leading minus sign like -P1DT2H,
+// and otherwise negative components are signed on their own like P1MT-2H, as in ISO 8601-2.
+func (p Period) String() string {
+	var b strings.Builder
+	sign := 1
+	if p != (Period{}) && p.Years <= 0 && p.Months <= 0 && p.Days <= 0 && p.Duration <= 0 {
+		b.WriteByte('-')
+		sign = -1
+	}
+	b.WriteByte('P')
+	date := b.Len()
+	for _, c := range []struct {
+		v int
+		d byte
+	}{{p.Years, 'Y'}, {p.Months, 'M'}, {p.Days, 'D'}} {
+		if c.v != 0 {
+			b.WriteString(strconv.Itoa(sign * c.v))
+			b.WriteByte(c.d)
+		}
+	}
+	if p.Duration == 0 {
+		if b.Len() == date {
+			b.WriteString("T0S")
+		}
+		return b.String()
+	}
+
+	// each part keeps the sign of the duration, so the minimum duration is not negated
+	b.WriteByte('T')
+	d := p.Duration
+	if h := d / time.Hour; h != 0 {
+		fmt.Fprintf(&b, "%dH", int64(sign)*int64(h))
+	}
+	if m := d % time.Hour / time.Minute; m != 0 {
+		fmt.Fprintf(&b, "%dM", int64(sign)*int64(m))
+	}
+	if s := d % time.Minute; s != 0 {
+		b.WriteString(strconv.FormatFloat(float64(sign)*s.Seconds(), 'f', -1, 64))
+		b.WriteByte('S')
+	}
+	return b.String()
+}
+
+// AddTo returns t plus period.
+// Years and months are added first, keeping the day of month
+// or clamping it to the end of the month, so 2020-01-31 plus P1M is 2020-02-29.
+// Then days are added on the calendar, and finally the time part.
+func (p Period) AddTo(t time.Time) time.Time {
+	if p.Years != 0 || p.Months != 0 {
+		y, m, d := t.Date()
+		first := time.Date(y+p.Years, m+time.Month(p.Months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+		if last := first.AddDate(0, 1, -1).Day(); d > last {
+			d = last
+		}
+		t = first.AddDate(0, 0, d-1)
+	}
+	return t.AddDate(0, 0, p.Days).Add(p.Duration)
+}
+
+// SubFrom returns t minus period.
+// It is AddTo with each component negated.
+func (p Period) SubFrom(t time.Time) time.Time {
+	return p.mul(-1).AddTo(t)
+}
+
+// mul returns period with each component multiplied by n.
+func (p Period) mul(n int) Period {
+	return Period{
+		Years:    p.Years * n,
+		Months:   p.Months * n,
+		Days:     p.Days * n,
+		Duration: p.Duration * time.Duration(n),
+	}
+}
+
+// ParseISO8601 parses an ISO 8601 time interval in one of the forms
+// start/end, start/duration, duration/end and duration.
+// A bare duration is placed to start at ref.
+// ".." may be used as an unbounded start or end, as in ISO 8601-2.
+//
+// ISO 8601 intervals are half-open, so the result has a closed lower endpoint
+// and an open upper endpoint. Times without a time zone are regarded as UTC.
+func ParseISO8601(s string, ref time.Time) (Interval[Time], error) {
+	first, second, ok := strings.Cut(s, "/")
+	if !ok {
+		p, err := ParsePeriod(s)
+		if err != nil {
+			return Interval[Time]{}, err
+		}
+		return New(ClosedEp(Time(ref)), OpenEp(Time(p.AddTo(ref)))), nil
+	}
+
+	switch {
+	case strings.HasPrefix(first, "P"):
+		p, err := ParsePeriod(first)
+		if err != nil {
+			return Interval[Time]{}, err
+		}
+		end, err := parseISOTime(second)
+		if err != nil {
+			return Interval[Time]{}, err
+		}
+		return New(ClosedEp(Time(p.SubFrom(end))), OpenEp(Time(end))), nil
+	case strings.HasPrefix(second, "P"):
+		start, err := parseISOTime(first)
+		if err != nil {
+			return Interval[Time]{}, err
+		}
+		p, err := ParsePeriod(second)
+		if err != nil {
+			return Interval[Time]{}, err
+		}
+		return New(ClosedEp(Time(start)), OpenEp(Time(p.AddTo(start)))), nil
+	}
+
+	lower, upper := UnboundedEp[Time](), UnboundedEp[Time]()
+	if first != ".." {
+		start, err := parseISOTime(first)
+		if err != nil {
+			return Interval[Time]{}, err
+		}
+		lower = ClosedEp(Time(start))
+	}
+	if second != ".." {
+		end, err := parseISOTime(second)
+		if err != nil {
+			return Interval[Time]{}, err
+		}
+		upper = OpenEp(Time(end))
+	}
+	return New(lower, upper), nil
+}
+
+// layouts of times accepted by ParseISO8601.
+var isoLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"20060102T150405.999999999Z0700",
+	"20060102T150405.999999999",
+	"2006-01-02",
+	"20060102",
+}
+
+func parseISOTime(s string) (time.Time, error) {
+	for _, layout := range isoLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %q", ErrSyntax, s)
+}
+
+// ISO8601Form is a form of ISO 8601 time intervals written by FormatISO8601.
+type ISO8601Form int
+
+const (
+	// ISO8601StartEnd is the start/end form, like 2020-01-01T00:00:00Z/2020-01-02T00:00:00Z.
+	ISO8601StartEnd ISO8601Form = iota
+	// ISO8601StartDuration is the start/duration form, like 2020-01-01T00:00:00Z/PT24H.
+	ISO8601StartDuration
+	// ISO8601DurationEnd is the duration/end form, like PT24H/2020-01-02T00:00:00Z.
+	ISO8601DurationEnd
+	// ISO8601Duration is the bare duration form, like PT24H,
+	// which ParseISO8601 reads back given the start as the reference time.
+	ISO8601Duration
+)
+
+// FormatISO8601 returns interval in the ISO 8601 form.
+// The duration is the exact time between the endpoints, written by Period.String,
+// so the forms with a duration require a bounded interval.
+// Unbounded endpoints are written as ".." in the start/end form.
+// Interval must be half-open, that is, its bounded lower endpoint must be closed
+// and its bounded upper endpoint must be open.
+func FormatISO8601(i Interval[Time], form ISO8601Form) (string, error) {
+	if i.IsEmpty() {
+		return "", errors.New("interval: empty interval has no ISO 8601 form")
+	}
+	if (i.Lower.Bounded() && !i.Lower.Closed) || (i.Upper.Bounded() && i.Upper.Closed) {
+		return "", fmt.Errorf("interval: %v is not half-open", i)
+	}
+	start, end := "..", ".."
+	if i.Lower.Bounded() {
+		start = time.Time(i.Lower.Value).Format(time.RFC3339Nano)
+	}
+	if i.Upper.Bounded() {
+		end = time.Time(i.Upper.Value).Format(time.RFC3339Nano)
+	}
+	if form == ISO8601StartEnd {
+		return start + "/" + end, nil
+	}
+
+	if !i.Lower.Bounded() || !i.Upper.Bounded() {
+		return "", fmt.Errorf("interval: %v has no ISO 8601 duration", i)
+	}
+	p := Period{Duration: time.Time(i.Upper.Value).Sub(time.Time(i.Lower.Value))}
+	switch form {
+	case ISO8601StartDuration:
+		return start + "/" + p.String(), nil
+	case ISO8601DurationEnd:
+		return p.String() + "/" + end, nil
+	case ISO8601Duration:
+		return p.String(), nil
+	}
+	return "", fmt.Errorf("interval: unknown ISO 8601 form %d", form)
+}
+
+// ParseISO8601Repeating parses an ISO 8601 repeating interval like R5/2020-01-01T00:00:00Z/P1D
+// and returns an iterator over its intervals.
+// Rn yields n intervals and R without a number repeats forever.
+//
+// With start/duration, the k-th interval starts at start plus k times duration on the calendar,
+// so monthly repetitions keep the day of month. With duration/end, the intervals repeat
+// backwards from end, so they are yielded in descending order. With start/end,
+// the exact time between them is repeated.
+func ParseISO8601Repeating(s string) (iter.Seq[Interval[Time]], error) {
+	r, rest, ok := strings.Cut(s, "/")
+	if !ok || !strings.HasPrefix(r, "R") {
+		return nil, fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+	n := -1
+	if r != "R" {
+		v, err := strconv.Atoi(r[1:])
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("%w: %q", ErrSyntax, s)
+		}
+		n = v
+	}
+
+	first, second, ok := strings.Cut(rest, "/")
+	if !ok || first == ".." || second == ".." {
+		return nil, fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+	i, err := ParseISO8601(rest, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	start, end := time.Time(i.Lower.Value), time.Time(i.Upper.Value)
+
+	var nth func(k int) Interval[Time]
+	switch {
+	case strings.HasPrefix(first, "P"):
+		p, _ := ParsePeriod(first)
+		nth = func(k int) Interval[Time] {
+			return New(ClosedEp(Time(p.mul(k+1).SubFrom(end))), OpenEp(Time(p.mul(k).SubFrom(end))))
+		}
+	case strings.HasPrefix(second, "P"):
+		p, _ := ParsePeriod(second)
+		nth = func(k int) Interval[Time] {
+			return New(ClosedEp(Time(p.mul(k).AddTo(start))), OpenEp(Time(p.mul(k+1).AddTo(start))))
+		}
+	default:
+		d := end.Sub(start)
+		nth = func(k int) Interval[Time] {
+			return New(ClosedEp(Time(start.Add(d*time.Duration(k)))), OpenEp(Time(start.Add(d*time.Duration(k+1)))))
+		}
+	}
+	return func(yield func(Interval[Time]) bool) {
+		for k := 0; n < 0 || k < n; k++ {
+			if !yield(nth(k)) {
+				return
+			}
+		}
+	}, nil
+}
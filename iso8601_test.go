@@ -0,0 +1,296 @@
+package interval
+
+import (
+	"iter"
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParsePeriod(t *testing.T) {
+	cases := []struct {
+		input string
+		want  Period
+		str   string
+	}{
+		{input: "P1Y2M10DT2H30M", want: Period{Years: 1, Months: 2, Days: 10, Duration: 2*time.Hour + 30*time.Minute}, str: "P1Y2M10DT2H30M"},
+		{input: "P1M", want: Period{Months: 1}, str: "P1M"},
+		{input: "PT1M", want: Period{Duration: time.Minute}, str: "PT1M"},
+		{input: "P2W", want: Period{Days: 14}, str: "P14D"},
+		{input: "PT0.5S", want: Period{Duration: 500 * time.Millisecond}, str: "PT0.5S"},
+		{input: "PT1,5H", want: Period{Duration: 90 * time.Minute}, str: "PT1H30M"},
+		{input: "P1DT36H", want: Period{Days: 1, Duration: 36 * time.Hour}, str: "P1DT36H"},
+		{input: "P0D", want: Period{}, str: "PT0S"},
+		{input: "-PT1H30M", want: Period{Duration: -90 * time.Minute}, str: "-PT1H30M"},
+		{input: "-P1DT0.5S", want: Period{Days: -1, Duration: -500 * time.Millisecond}, str: "-P1DT0.5S"},
+		{input: "P1MT-1H", want: Period{Months: 1, Duration: -time.Hour}, str: "P1MT-1H"},
+		{input: "P-1Y2M", want: Period{Years: -1, Months: 2}, str: "P-1Y2M"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			got, err := ParsePeriod(c.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, c.want, got)
+			assertEqual(t, c.str, got.String())
+		})
+	}
+
+	for _, s := range []string{"", "P", "PT", "1Y", "P1", "P1H", "PT1D", "P1.5Y", "PY", "P1YT", "-", "--P1D", "P-D", "PT-H"} {
+		if _, err := ParsePeriod(s); err == nil {
+			t.Errorf("want error for %q, got nil", s)
+		}
+	}
+}
+
+func TestPeriodAddTo(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 10, 0, 0, 0, time.UTC)
+	}
+	cases := []struct {
+		name string
+		p    Period
+		t    time.Time
+		want time.Time
+	}{
+		{name: "month", p: Period{Months: 1}, t: date(2020, 1, 15), want: date(2020, 2, 15)},
+		{name: "month end is clamped", p: Period{Months: 1}, t: date(2020, 1, 31), want: date(2020, 2, 29)},
+		{name: "year over leap day", p: Period{Years: 1}, t: date(2020, 2, 29), want: date(2021, 2, 28)},
+		{name: "months over a year", p: Period{Months: 13}, t: date(2020, 12, 31), want: date(2022, 1, 31)},
+		{name: "all", p: Period{Years: 1, Months: 2, Days: 10, Duration: 2*time.Hour + 30*time.Minute}, t: date(2020, 1, 1), want: time.Date(2021, 3, 11, 12, 30, 0, 0, time.UTC)},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, c.p.AddTo(c.t))
+		})
+	}
+
+	assertEqual(t, date(2020, 2, 29), Period{Months: 1}.SubFrom(date(2020, 3, 31)))
+}
+
+func TestPeriodStringRoundTrip(t *testing.T) {
+	for _, p := range []Period{
+		{Duration: -time.Nanosecond},
+		{Duration: math.MinInt64},
+		{Duration: math.MaxInt64},
+		{Years: 2, Days: -3, Duration: 61 * time.Second},
+		{Months: -1, Duration: -(25*time.Hour + 1500*time.Millisecond)},
+	} {
+		got, err := ParsePeriod(p.String())
+		if err != nil {
+			t.Fatalf("%s: %v", p, err)
+		}
+		assertEqual(t, p, got)
+	}
+}
+
+func TestParseISO8601(t *testing.T) {
+	ref := time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)
+	half := func(start, end time.Time) Interval[Time] {
+		return New(ClosedEp(Time(start)), OpenEp(Time(end)))
+	}
+	cases := []struct {
+		input string
+		want  Interval[Time]
+	}{
+		{
+			input: "2020-01-01T00:00:00Z/2020-01-02T12:00:00Z",
+			want:  half(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 2, 12, 0, 0, 0, time.UTC)),
+		},
+		{
+			input: "2020-01-01T00:00:00Z/P1M",
+			want:  half(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			input: "P1DT2H/2020-01-02T12:00:00+09:00",
+			want:  half(time.Date(2020, 1, 1, 10, 0, 0, 0, time.FixedZone("", 9*60*60)), time.Date(2020, 1, 2, 3, 0, 0, 0, time.UTC)),
+		},
+		{
+			input: "P1M",
+			want:  half(ref, time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			input: "20200101T000000Z/2020-01-02",
+			want:  half(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			input: "../2020-01-02T00:00:00",
+			want:  New(UnboundedEp[Time](), OpenEp(Time(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)))),
+		},
+		{
+			input: "2020-01-01/..",
+			want:  New(ClosedEp(Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))), UnboundedEp[Time]()),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			got, err := ParseISO8601(c.input, ref)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(c.want) || got.Lower.Closed != c.want.Lower.Closed || got.Upper.Closed != c.want.Upper.Closed {
+				t.Errorf("want %v, got %v", c.want, got)
+			}
+		})
+	}
+
+	for _, s := range []string{"", "2020-01-01", "2020-01-01/", "P1D/P1D", "2020-13-01/2021-01-01", "2020-01-01/P1X"} {
+		if _, err := ParseISO8601(s, ref); err == nil {
+			t.Errorf("want error for %q, got nil", s)
+		}
+	}
+}
+
+func TestFormatISO8601(t *testing.T) {
+	start := Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	end := Time(time.Date(2020, 1, 2, 12, 0, 0, 5, time.UTC))
+	i := New(ClosedEp(start), OpenEp(end))
+	cases := []struct {
+		name string
+		i    Interval[Time]
+		form ISO8601Form
+		want string
+	}{
+		{name: "start/end", i: i, form: ISO8601StartEnd, want: "2020-01-01T00:00:00Z/2020-01-02T12:00:00.000000005Z"},
+		{name: "start/duration", i: i, form: ISO8601StartDuration, want: "2020-01-01T00:00:00Z/PT36H0.000000005S"},
+		{name: "duration/end", i: i, form: ISO8601DurationEnd, want: "PT36H0.000000005S/2020-01-02T12:00:00.000000005Z"},
+		{name: "duration", i: i, form: ISO8601Duration, want: "PT36H0.000000005S"},
+		{name: "unbounded start", i: New(UnboundedEp[Time](), OpenEp(end)), form: ISO8601StartEnd, want: "../2020-01-02T12:00:00.000000005Z"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s, err := FormatISO8601(c.i, c.form)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, c.want, s)
+
+			got, err := ParseISO8601(s, time.Time(start))
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, true, got.Equal(c.i))
+		})
+	}
+
+	for _, c := range []struct {
+		i    Interval[Time]
+		form ISO8601Form
+	}{
+		{i: Interval[Time]{}, form: ISO8601StartEnd},
+		{i: New(OpenEp(start), OpenEp(end)), form: ISO8601StartEnd},
+		{i: New(ClosedEp(start), ClosedEp(end)), form: ISO8601StartEnd},
+		{i: New(UnboundedEp[Time](), OpenEp(end)), form: ISO8601DurationEnd},
+		{i: New(ClosedEp(start), UnboundedEp[Time]()), form: ISO8601StartDuration},
+		{i: New(ClosedEp(start), UnboundedEp[Time]()), form: ISO8601Duration},
+		{i: i, form: ISO8601Form(-1)},
+	} {
+		if _, err := FormatISO8601(c.i, c.form); err == nil {
+			t.Errorf("want error for %v in form %d, got nil", c.i, c.form)
+		}
+	}
+}
+
+func TestParseISO8601Repeating(t *testing.T) {
+	day := func(m time.Month, d int) Time {
+		return Time(time.Date(2020, m, d, 0, 0, 0, 0, time.UTC))
+	}
+	cases := []struct {
+		input string
+		limit int
+		want  []Interval[Time]
+	}{
+		{
+			input: "R3/2020-01-01T00:00:00Z/P1D",
+			want: []Interval[Time]{
+				New(ClosedEp(day(1, 1)), OpenEp(day(1, 2))),
+				New(ClosedEp(day(1, 2)), OpenEp(day(1, 3))),
+				New(ClosedEp(day(1, 3)), OpenEp(day(1, 4))),
+			},
+		},
+		{
+			input: "R3/2020-01-31T00:00:00Z/P1M",
+			want: []Interval[Time]{
+				New(ClosedEp(day(1, 31)), OpenEp(day(2, 29))),
+				New(ClosedEp(day(2, 29)), OpenEp(day(3, 31))),
+				New(ClosedEp(day(3, 31)), OpenEp(day(4, 30))),
+			},
+		},
+		{
+			input: "R2/P1D/2020-01-03T00:00:00Z",
+			want: []Interval[Time]{
+				New(ClosedEp(day(1, 2)), OpenEp(day(1, 3))),
+				New(ClosedEp(day(1, 1)), OpenEp(day(1, 2))),
+			},
+		},
+		{
+			input: "R/2020-01-01T00:00:00Z/2020-01-03T00:00:00Z",
+			limit: 2,
+			want: []Interval[Time]{
+				New(ClosedEp(day(1, 1)), OpenEp(day(1, 3))),
+				New(ClosedEp(day(1, 3)), OpenEp(day(1, 5))),
+			},
+		},
+		{
+			input: "R/P1D/2020-01-03T00:00:00Z",
+			limit: 1,
+			want: []Interval[Time]{
+				New(ClosedEp(day(1, 2)), OpenEp(day(1, 3))),
+			},
+		},
+		{
+			input: "R99999999999999/2020-01-01T00:00:00Z/P1D",
+			limit: 1,
+			want: []Interval[Time]{
+				New(ClosedEp(day(1, 1)), OpenEp(day(1, 2))),
+			},
+		},
+		{
+			input: "R0/2020-01-01T00:00:00Z/P1D",
+			want:  nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.input, func(t *testing.T) {
+			seq, err := ParseISO8601Repeating(c.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.limit > 0 {
+				seq = take(seq, c.limit)
+			}
+			assertDeepEqual(t, c.want, slices.Collect(seq))
+		})
+	}
+
+	for _, s := range []string{"", "R5", "Rx/2020-01-01T00:00:00Z/P1D", "R-1/2020-01-01T00:00:00Z/P1D", "R99999999999999999999/2020-01-01T00:00:00Z/P1D", "R5/../2020-01-01T00:00:00Z", "R5/2020-01-01T00:00:00Z", "5/2020-01-01T00:00:00Z/P1D"} {
+		if _, err := ParseISO8601Repeating(s); err == nil {
+			t.Errorf("want error for %q, got nil", s)
+		}
+	}
+}
+
+// take returns an iterator over the first n values of seq.
+func take[V any](seq iter.Seq[V], n int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		if n <= 0 {
+			return
+		}
+		k := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			if k++; k == n {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package interval
+
+import "iter"
+
+// JoinMode is the kind of a join of interval sequences.
+type JoinMode int
+
+const (
+	// InnerJoin yields the matching pairs only.
+	InnerJoin JoinMode = iota
+	// LeftOuterJoin also yields each left entry having no match,
+	// paired with the zero Entry whose interval is empty.
+	LeftOuterJoin
+)
+
+// joinEntry is an entry of a join with its canonical interval.
+type joinEntry[T Ordered[T], V any] struct {
+	entry     Entry[T, V]
+	canonical Interval[T]
+	matched   bool
+}
+
+// Join merges two sequences of intervals and values sorted by lower endpoints,
+// and yields the pairs of entries whose intervals overlap, as with Interval.Overlaps.
+// Only the intervals active at the current position are kept in memory,
+// so both sequences may be long, and right may be endless.
+//
+// When match is not nil, the pairs whose intervals overlap or meet,
+// that is, the pairs in relations other than before and after,
+// are yielded if match returns true for the relation of the left interval to the right one.
+// For example, match may accept AllenMeets and AllenMetBy to join touching intervals.
+//
+// Pairs are yielded when the later of them starts, and in LeftOuterJoin mode
+// left entries without a match are yielded once no later right interval can match them.
+// Empty intervals never match.
+func Join[T Ordered[T], L, R any](left iter.Seq2[Interval[T], L], right iter.Seq2[Interval[T], R], mode JoinMode, match func(AllenRelation) bool) iter.Seq2[Entry[T, L], Entry[T, R]] {
+	if match == nil {
+		match = func(r AllenRelation) bool {
+			return r != AllenMeets && r != AllenMetBy
+		}
+	}
+	return func(yield func(Entry[T, L], Entry[T, R]) bool) {
+		nextLeft, stopLeft := iter.Pull2(left)
+		defer stopLeft()
+		nextRight, stopRight := iter.Pull2(right)
+		defer stopRight()
+
+		d := domainOf[T]()
+		compare := d.compare
+		var (
+			lefts  []joinEntry[T, L]
+			rights []joinEntry[T, R]
+		)
+		// unmatched yields a left entry without a match in LeftOuterJoin mode.
+		unmatched := func(e joinEntry[T, L]) bool {
+			return mode != LeftOuterJoin || e.matched || yield(e.entry, Entry[T, R]{})
+		}
+		// evict drops the active entries ending before the lower endpoint,
+		// which no later interval can touch.
+		evict := func(lower Endpoint[T]) bool {
+			k := 0
+			for _, e := range lefts {
+				if compare.ep(e.canonical.Upper, true, lower, false) >= 0 {
+					lefts[k] = e
+					k++
+				} else if !unmatched(e) {
+					return false
+				}
+			}
+			clear(lefts[k:])
+			lefts = lefts[:k]
+
+			k = 0
+			for _, e := range rights {
+				if compare.ep(e.canonical.Upper, true, lower, false) >= 0 {
+					rights[k] = e
+					k++
+				}
+			}
+			clear(rights[k:])
+			rights = rights[:k]
+			return true
+		}
+
+		l, ok := pullJoinEntry(nextLeft, d)
+		r, ok2 := pullJoinEntry(nextRight, d)
+		// no right interval can match once the left ones are exhausted
+		for ok || (ok2 && len(lefts) > 0) {
+			// empty intervals have no position to merge by
+			if ok && l.canonical.isEmpty(compare) {
+				if !unmatched(l) {
+					return
+				}
+				l, ok = pullJoinEntry(nextLeft, d)
+				continue
+			}
+			if ok2 && r.canonical.isEmpty(compare) {
+				r, ok2 = pullJoinEntry(nextRight, d)
+				continue
+			}
+
+			if ok && (!ok2 || compare.ep(l.canonical.Lower, false, r.canonical.Lower, false) <= 0) {
+				if !evict(l.canonical.Lower) {
+					return
+				}
+				for _, e := range rights {
+					if rel := l.canonical.relation(e.canonical, compare); rel != AllenBefore && rel != AllenAfter && match(rel) {
+						l.matched = true
+						if !yield(l.entry, e.entry) {
+							return
+						}
+					}
+				}
+				lefts = append(lefts, l)
+				l, ok = pullJoinEntry(nextLeft, d)
+				continue
+			}
+
+			if !evict(r.canonical.Lower) {
+				return
+			}
+			for k := range lefts {
+				if rel := lefts[k].canonical.relation(r.canonical, compare); rel != AllenBefore && rel != AllenAfter && match(rel) {
+					lefts[k].matched = true
+					if !yield(lefts[k].entry, r.entry) {
+						return
+					}
+				}
+			}
+			rights = append(rights, r)
+			r, ok2 = pullJoinEntry(nextRight, d)
+		}
+		for _, e := range lefts {
+			if !unmatched(e) {
+				return
+			}
+		}
+	}
+}
+
+// pullJoinEntry returns the next entry of a pulled sequence.
+func pullJoinEntry[T Ordered[T], V any](next func() (Interval[T], V, bool), d domain[T]) (joinEntry[T, V], bool) {
+	i, v, ok := next()
+	if !ok {
+		return joinEntry[T, V]{}, false
+	}
+	return joinEntry[T, V]{
+		entry:     Entry[T, V]{Interval: i, Value: v},
+		canonical: d.canonical(i),
+	}, true
+}
@@ -0,0 +1,135 @@
+package interval
+
+import (
+	"iter"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// entries returns the sequence of intervals paired with their indices.
+func entries[T Ordered[T]](intervals []Interval[T]) iter.Seq2[Interval[T], int] {
+	return func(yield func(Interval[T], int) bool) {
+		for k, i := range intervals {
+			if !yield(i, k) {
+				return
+			}
+		}
+	}
+}
+
+// joined collects the pairs of indices yielded by a join, using -1 for missing right entries.
+func joined[T Ordered[T]](seq iter.Seq2[Entry[T, int], Entry[T, int]]) [][2]int {
+	var res [][2]int
+	for l, r := range seq {
+		if r.Interval.IsEmpty() {
+			res = append(res, [2]int{l.Value, -1})
+		} else {
+			res = append(res, [2]int{l.Value, r.Value})
+		}
+	}
+	return res
+}
+
+func TestJoin(t *testing.T) {
+	f := func(v float64) Float64 { return Float64(v) }
+	deployments := []Interval[Float64]{
+		ClosedOpen(f(0), f(10)),
+		ClosedOpen(f(10), f(20)),
+		ClosedOpen(f(20), f(30)),
+		ClosedOpen(f(30), f(40)),
+	}
+	errors := []Interval[Float64]{
+		Closed(f(5), f(12)),
+		Closed(f(8), f(9)),
+		Closed(f(20), f(20)),
+		Open(f(25), f(25)),
+		AtLeast(f(40)),
+	}
+
+	assertDeepEqual(t, [][2]int{{0, 0}, {0, 1}, {1, 0}, {2, 2}},
+		joined(Join(entries(deployments), entries(errors), InnerJoin, nil)))
+	assertDeepEqual(t, [][2]int{{0, 0}, {0, 1}, {1, 0}, {2, 2}, {3, -1}},
+		joined(Join(entries(deployments), entries(errors), LeftOuterJoin, nil)))
+	assertDeepEqual(t, [][2]int{{1, 2}, {3, 4}},
+		joined(Join(entries(deployments), entries(errors), InnerJoin, func(r AllenRelation) bool {
+			return r == AllenMeets
+		})))
+	assertDeepEqual(t, [][2]int{{0, 1}},
+		joined(Join(entries(deployments), entries(errors), InnerJoin, func(r AllenRelation) bool {
+			return r == AllenContains
+		})))
+	assertDeepEqual(t, [][2]int{{0, -1}, {1, -1}, {2, -1}, {3, -1}},
+		joined(Join(entries(deployments), entries[Float64](nil), LeftOuterJoin, nil)))
+	assertDeepEqual(t, [][2]int{{0, -1}},
+		joined(Join(entries([]Interval[Float64]{Open(f(5), f(5))}), entries(errors), LeftOuterJoin, nil)))
+}
+
+func TestJoinEndless(t *testing.T) {
+	// one-hour windows from 0
+	windows := func(yield func(Interval[Int], int) bool) {
+		for k := 0; ; k++ {
+			if !yield(ClosedOpen(Int(k*60), Int(k*60+60)), k) {
+				return
+			}
+		}
+	}
+	events := entries([]Interval[Int]{Closed(Int(30), Int(90)), Closed(Int(150), Int(150))})
+
+	assertDeepEqual(t, [][2]int{{0, 0}, {0, 1}, {1, 2}}, joined(Join(events, windows, InnerJoin, nil)))
+
+	var res [][2]int
+	for l, r := range Join(windows, events, LeftOuterJoin, nil) {
+		res = append(res, [2]int{l.Value, r.Value})
+		if len(res) == 4 {
+			break
+		}
+	}
+	assertDeepEqual(t, [][2]int{{0, 0}, {1, 0}, {2, 1}, {3, 0}}, res)
+}
+
+// TestJoinRandom compares the results with the nested loop join.
+func TestJoinRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func() []Interval[Int] {
+		intervals := make([]Interval[Int], r.Intn(20))
+		for k := range intervals {
+			lower := Int(r.Intn(40))
+			intervals[k] = New(Endpoint[Int]{Value: lower, Closed: r.Intn(2) == 0}, Endpoint[Int]{Value: lower + Int(r.Intn(8)), Closed: r.Intn(2) == 0})
+		}
+		Sort(intervals)
+		return intervals
+	}
+	sorted := func(pairs [][2]int) [][2]int {
+		sort.Slice(pairs, func(a, b int) bool {
+			return pairs[a][0] < pairs[b][0] || (pairs[a][0] == pairs[b][0] && pairs[a][1] < pairs[b][1])
+		})
+		return pairs
+	}
+	touching := func(r AllenRelation) bool {
+		return r == AllenMeets || r == AllenMetBy || r == AllenEquals
+	}
+
+	for n := 0; n < 200; n++ {
+		left, right := random(), random()
+		var inner, outer, touch [][2]int
+		for k, i := range left {
+			matched := false
+			for k2, i2 := range right {
+				if i.Overlaps(i2) {
+					inner = append(inner, [2]int{k, k2})
+					matched = true
+				}
+				if rel := i.Relation(i2); touching(rel) {
+					touch = append(touch, [2]int{k, k2})
+				}
+			}
+			if !matched {
+				outer = append(outer, [2]int{k, -1})
+			}
+		}
+		assertDeepEqual(t, sorted(inner), sorted(joined(Join(entries(left), entries(right), InnerJoin, nil))))
+		assertDeepEqual(t, sorted(touch), sorted(joined(Join(entries(left), entries(right), InnerJoin, touching))))
+		assertDeepEqual(t, sorted(append(outer, inner...)), sorted(joined(Join(entries(left), entries(right), LeftOuterJoin, nil))))
+	}
+}
@@ -0,0 +1,94 @@
+package interval
+
+import "sort"
+
+// IntervalMap maps disjoint intervals to values.
+// Putting a value overwrites the values of the points it covers,
+// splitting existing entries at its endpoints, and entries touching each other
+// with equal values are merged.
+// The zero value of IntervalMap is an empty map.
+type IntervalMap[T Ordered[T], V comparable] struct {
+	entries []Entry[T, V]
+}
+
+// Entries returns the entries of map ordered by their intervals.
+func (m IntervalMap[T, V]) Entries() []Entry[T, V] {
+	return append([]Entry[T, V](nil), m.entries...)
+}
+
+// Len returns the number of entries in map.
+func (m IntervalMap[T, V]) Len() int {
+	return len(m.entries)
+}
+
+// Get returns the value mapped to the point with given value.
+// It returns false if no entry covers the point.
+func (m IntervalMap[T, V]) Get(p T) (V, bool) {
+	e, ok := m.GetEntry(p)
+	return e.Value, ok
+}
+
+// GetEntry returns the entry covering the point with given value.
+// It returns false if no entry covers the point.
+func (m IntervalMap[T, V]) GetEntry(p T) (Entry[T, V], bool) {
+	// find the first entry which does not end before p
+	compare := comparer[T]()
+	k := sort.Search(len(m.entries), func(k int) bool {
+		return !m.entries[k].Interval.endsBefore(p, compare)
+	})
+	if k < len(m.entries) && m.entries[k].Interval.Contains(p) {
+		return m.entries[k], true
+	}
+	return Entry[T, V]{}, false
+}
+
+// Put maps the points of interval to value.
+func (m *IntervalMap[T, V]) Put(i Interval[T], v V) {
+	if i.IsEmpty() {
+		return
+	}
+	entries := m.remove(i)
+	compare := comparer[T]()
+	k := sort.Search(len(entries), func(k int) bool {
+		return compare.ep(entries[k].Interval.Lower, false, i.Lower, false) > 0
+	})
+	entries = append(entries, Entry[T, V]{})
+	copy(entries[k+1:], entries[k:])
+	entries[k] = Entry[T, V]{Interval: i, Value: v}
+	m.entries = coalesce(entries)
+}
+
+// Remove removes the mappings of the points of interval.
+func (m *IntervalMap[T, V]) Remove(i Interval[T]) {
+	m.entries = m.remove(i)
+}
+
+// remove returns the entries of m with the points of interval removed.
+func (m IntervalMap[T, V]) remove(i Interval[T]) []Entry[T, V] {
+	res := make([]Entry[T, V], 0, len(m.entries)+1)
+	for _, e := range m.entries {
+		for _, d := range e.Interval.Difference(i) {
+			res = append(res, Entry[T, V]{Interval: d, Value: e.Value})
+		}
+	}
+	return res
+}
+
+// coalesce merges adjacent entries of sorted, disjoint entries
+// when they touch each other and have equal values.
+func coalesce[T Ordered[T], V comparable](entries []Entry[T, V]) []Entry[T, V] {
+	if len(entries) == 0 {
+		return nil
+	}
+	d := domainOf[T]()
+	res := entries[:1]
+	for _, e := range entries[1:] {
+		last := &res[len(res)-1]
+		if last.Value == e.Value && d.canonical(last.Interval).connected(d.canonical(e.Interval), d.compare) {
+			last.Interval = last.Interval.hull(e.Interval, d)
+		} else {
+			res = append(res, e)
+		}
+	}
+	return res
+}
@@ -0,0 +1,67 @@
+package interval
+
+import "testing"
+
+func TestIntervalMap(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	var m IntervalMap[Int, string]
+	m.Put(New(ClosedEp(Int(0)), OpenEp(Int(10))), "a")
+	m.Put(New(ClosedEp(Int(3)), ClosedEp(Int(5))), "b")
+	m.Put(New(OpenEp(Int(5)), OpenEp(Int(7))), "b")
+	m.Put(New(ClosedEp(Int(20)), unbounded), "c")
+	m.Put(New(OpenEp(Int(3)), OpenEp(Int(3))), "empty")
+
+	assertDeepEqual(t, []Entry[Int, string]{
+		{Interval: New(ClosedEp(Int(0)), OpenEp(Int(3))), Value: "a"},
+		{Interval: New(ClosedEp(Int(3)), OpenEp(Int(7))), Value: "b"},
+		{Interval: New(ClosedEp(Int(7)), OpenEp(Int(10))), Value: "a"},
+		{Interval: New(ClosedEp(Int(20)), unbounded), Value: "c"},
+	}, m.Entries())
+	assertEqual(t, 4, m.Len())
+
+	t.Run("Get", func(t *testing.T) {
+		cases := []struct {
+			point Int
+			want  string
+			ok    bool
+		}{
+			{point: Int(-1), want: "", ok: false},
+			{point: Int(0), want: "a", ok: true},
+			{point: Int(3), want: "b", ok: true},
+			{point: Int(6), want: "b", ok: true},
+			{point: Int(7), want: "a", ok: true},
+			{point: Int(10), want: "", ok: false},
+			{point: Int(100), want: "c", ok: true},
+		}
+		for _, c := range cases {
+			v, ok := m.Get(c.point)
+			assertEqual(t, c.want, v)
+			assertEqual(t, c.ok, ok)
+		}
+	})
+
+	t.Run("GetEntry", func(t *testing.T) {
+		e, ok := m.GetEntry(Int(4))
+		assertEqual(t, true, ok)
+		assertEqual(t, New(ClosedEp(Int(3)), OpenEp(Int(7))), e.Interval)
+	})
+
+	t.Run("Put merges equal values", func(t *testing.T) {
+		var m IntervalMap[Int, string]
+		m.Put(New(ClosedEp(Int(0)), OpenEp(Int(3))), "a")
+		m.Put(New(ClosedEp(Int(5)), OpenEp(Int(10))), "a")
+		m.Put(New(ClosedEp(Int(3)), OpenEp(Int(5))), "a")
+		assertDeepEqual(t, []Entry[Int, string]{
+			{Interval: New(ClosedEp(Int(0)), OpenEp(Int(10))), Value: "a"},
+		}, m.Entries())
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		m.Remove(New(ClosedEp(Int(5)), ClosedEp(Int(25))))
+		assertDeepEqual(t, []Entry[Int, string]{
+			{Interval: New(ClosedEp(Int(0)), OpenEp(Int(3))), Value: "a"},
+			{Interval: New(ClosedEp(Int(3)), OpenEp(Int(5))), Value: "b"},
+			{Interval: New(OpenEp(Int(25)), unbounded), Value: "c"},
+		}, m.Entries())
+	})
+}
@@ -0,0 +1,141 @@
+package interval
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrUnbounded indicates that an interval has no finite size or midpoint.
+	ErrUnbounded = errors.New("interval: unbounded interval")
+	// ErrEmpty indicates that an interval has no point to measure from.
+	ErrEmpty = errors.New("interval: empty interval")
+)
+
+// Number is a constraint for the types of distances.
+type Number interface {
+	integer | ~float32 | ~float64
+}
+
+// Metric measures the distances between values of T in D.
+type Metric[T any, D Number] interface {
+	// Distance returns the distance from a to b, where a is not greater than b.
+	Distance(a, b T) D
+}
+
+// Midpointer finds the middle of two values of T.
+type Midpointer[T any] interface {
+	// Midpoint returns the value halfway from a to b, where a is not greater than b.
+	Midpoint(a, b T) T
+}
+
+var (
+	_ Metric[Int, uint64]         = IntMetric{}
+	_ Midpointer[Int]             = IntMetric{}
+	_ Metric[Time, time.Duration] = TimeMetric{}
+	_ Midpointer[Time]            = TimeMetric{}
+)
+
+// IntMetric measures Int values by the number of steps between them.
+type IntMetric struct{}
+
+// Distance returns b-a, which never overflows as uint64.
+func (IntMetric) Distance(a, b Int) uint64 {
+	return a.Steps(b)
+}
+
+// Midpoint returns the middle of a and b, rounded toward a.
+func (IntMetric) Midpoint(a, b Int) Int {
+	return Int(uint64(a) + a.Steps(b)/2)
+}
+
+// TimeMetric measures Time values by time.Duration.
+// Distances longer than about 292 years are saturated as time.Time.Sub does.
+type TimeMetric struct{}
+
+// Distance returns b.Sub(a).
+func (TimeMetric) Distance(a, b Time) time.Duration {
+	return time.Time(b).Sub(time.Time(a))
+}
+
+// Midpoint returns the middle of a and b, truncated to nanoseconds.
+func (TimeMetric) Midpoint(a, b Time) Time {
+	// a.Add(b.Sub(a)/2) saturates for long distances, so halve seconds and nanoseconds
+	ta, tb := time.Time(a), time.Time(b)
+	sec := tb.Unix() - ta.Unix()
+	nsec := int64(tb.Nanosecond() - ta.Nanosecond())
+	mid := time.Unix(ta.Unix()+sec/2, int64(ta.Nanosecond())+(sec%2*1e9+nsec)/2)
+	return Time(mid.In(ta.Location()))
+}
+
+// Length returns the distance from the lower endpoint to the upper endpoint of interval.
+// Discrete intervals are measured in their canonical forms,
+// so the length of [1, 3] for Int is 3, the number of the points.
+// It returns zero for empty intervals and ErrUnbounded for unbounded intervals.
+func Length[T Ordered[T], D Number](i Interval[T], m Metric[T, D]) (D, error) {
+	d := domainOf[T]()
+	i = d.canonical(i)
+	if i.isEmpty(d.compare) {
+		return 0, nil
+	}
+	if i.Lower.Unbounded || i.Upper.Unbounded {
+		return 0, ErrUnbounded
+	}
+	return m.Distance(i.Lower.Value, i.Upper.Value), nil
+}
+
+// Distance returns the length of the gap between the intervals.
+// Discrete intervals are measured in their canonical forms,
+// so the distance between [1, 3] and [6, 8] for Int is 2, the number of the points between them.
+// It returns zero for intervals sharing a point or touching each other,
+// and ErrEmpty if either interval is empty.
+func Distance[T Ordered[T], D Number](i, i2 Interval[T], m Metric[T, D]) (D, error) {
+	d := domainOf[T]()
+	i, i2 = d.canonical(i), d.canonical(i2)
+	if i.isEmpty(d.compare) || i2.isEmpty(d.compare) {
+		return 0, ErrEmpty
+	}
+	switch {
+	case d.compare.ep(i.Upper, true, i2.Lower, false) < 0:
+		return m.Distance(i.Upper.Value, i2.Lower.Value), nil
+	case d.compare.ep(i2.Upper, true, i.Lower, false) < 0:
+		return m.Distance(i2.Upper.Value, i.Lower.Value), nil
+	}
+	return 0, nil
+}
+
+// Midpoint returns the value halfway between the endpoints of interval.
+// Discrete intervals are measured between their first and last points,
+// so the midpoint of both [0, 9] and [0, 10) for Int is 4.
+// It returns ErrEmpty for empty intervals and ErrUnbounded for unbounded intervals.
+func Midpoint[T Ordered[T]](i Interval[T], m Midpointer[T]) (T, error) {
+	var zero T
+	d := domainOf[T]()
+	i = d.canonical(i)
+	if i.isEmpty(d.compare) {
+		return zero, ErrEmpty
+	}
+	if i.Lower.Unbounded || i.Upper.Unbounded {
+		return zero, ErrUnbounded
+	}
+	upper := i.Upper.Value
+	if d.prev != nil && !i.Upper.Closed {
+		upper, _ = d.prev(upper)
+	}
+	return m.Midpoint(i.Lower.Value, upper), nil
+}
+
+// Measure returns the total length of the points contained in intervals,
+// where the points shared by several intervals are counted once.
+// It returns ErrUnbounded if any of intervals is unbounded and not empty.
+func Measure[T Ordered[T], D Number](intervals []Interval[T], m Metric[T, D]) (D, error) {
+	var sum D
+	for _, i := range NewSet(intervals...).intervals {
+		l, err := Length(i, m)
+		if err != nil {
+			return 0, err
+		}
+		sum += l
+	}
+	return sum, nil
+}
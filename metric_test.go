@@ -0,0 +1,132 @@
+package interval
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLength(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	cases := []struct {
+		name string
+		i    Interval[Int]
+		want uint64
+		err  error
+	}{
+		{"closed", New(ClosedEp(Int(1)), ClosedEp(Int(3))), 3, nil},
+		{"closed-open", New(ClosedEp(Int(1)), OpenEp(Int(3))), 2, nil},
+		{"open", New(OpenEp(Int(1)), OpenEp(Int(3))), 1, nil},
+		{"empty", New(OpenEp(Int(1)), OpenEp(Int(2))), 0, nil},
+		{"whole range", New(ClosedEp(Int(math.MinInt)), OpenEp(Int(math.MaxInt))), math.MaxUint64, nil},
+		{"unbounded", New(unbounded, ClosedEp(Int(3))), 0, ErrUnbounded},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := Length[Int, uint64](c.i, IntMetric{})
+			if !errors.Is(err, c.err) {
+				t.Fatalf("want error %v, got %v", c.err, err)
+			}
+			assertEqual(t, c.want, got)
+		})
+	}
+
+	t.Run("time", func(t *testing.T) {
+		t1 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		i := New(ClosedEp(Time(t1)), OpenEp(Time(t1.Add(90*time.Minute))))
+		got, err := Length[Time, time.Duration](i, TimeMetric{})
+		assertEqual(t, nil, err)
+		assertEqual(t, 90*time.Minute, got)
+	})
+}
+
+func TestDistance(t *testing.T) {
+	i := func(lower, upper int) Interval[Int] {
+		return New(ClosedEp(Int(lower)), ClosedEp(Int(upper)))
+	}
+	cases := []struct {
+		name  string
+		i, i2 Interval[Int]
+		want  uint64
+		err   error
+	}{
+		{"before", i(1, 3), i(6, 8), 2, nil},
+		{"after", i(6, 8), i(1, 3), 2, nil},
+		{"adjacent", i(1, 3), i(4, 8), 0, nil},
+		{"overlapping", i(1, 5), i(4, 8), 0, nil},
+		{"unbounded", New(UnboundedEp[Int](), ClosedEp(Int(3))), New(OpenEp(Int(5)), UnboundedEp[Int]()), 2, nil},
+		{"empty", i(1, 3), i(5, 4), 0, ErrEmpty},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := Distance[Int, uint64](c.i, c.i2, IntMetric{})
+			if !errors.Is(err, c.err) {
+				t.Fatalf("want error %v, got %v", c.err, err)
+			}
+			assertEqual(t, c.want, got)
+		})
+	}
+
+	t.Run("time", func(t *testing.T) {
+		t1 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		i := New(ClosedEp(Time(t1)), OpenEp(Time(t1.Add(time.Hour))))
+		i2 := New(ClosedEp(Time(t1.Add(3*time.Hour))), OpenEp(Time(t1.Add(4*time.Hour))))
+		got, err := Distance[Time, time.Duration](i, i2, TimeMetric{})
+		assertEqual(t, nil, err)
+		assertEqual(t, 2*time.Hour, got)
+	})
+}
+
+func TestMidpoint(t *testing.T) {
+	got, err := Midpoint[Int](New(ClosedEp(Int(1)), ClosedEp(Int(4))), IntMetric{})
+	assertEqual(t, nil, err)
+	assertEqual(t, Int(2), got)
+
+	got, err = Midpoint[Int](New(ClosedEp(Int(math.MinInt)), ClosedEp(Int(math.MaxInt))), IntMetric{})
+	assertEqual(t, nil, err)
+	assertEqual(t, Int(-1), got)
+
+	// equal discrete intervals have the same midpoint
+	for _, i := range []Interval[Int]{
+		New(ClosedEp(Int(0)), ClosedEp(Int(9))),
+		New(ClosedEp(Int(0)), OpenEp(Int(10))),
+		New(OpenEp(Int(-1)), OpenEp(Int(10))),
+	} {
+		got, err = Midpoint[Int](i, IntMetric{})
+		assertEqual(t, nil, err)
+		assertEqual(t, Int(4), got)
+	}
+	_, err = Midpoint[Int](New(OpenEp(Int(1)), OpenEp(Int(2))), IntMetric{})
+	assertEqual(t, ErrEmpty, err)
+
+	_, err = Midpoint[Int](New(UnboundedEp[Int](), ClosedEp(Int(4))), IntMetric{})
+	assertEqual(t, ErrUnbounded, err)
+	_, err = Midpoint[Int](Interval[Int]{}, IntMetric{})
+	assertEqual(t, ErrEmpty, err)
+
+	// longer than time.Duration, but each half fits in it
+	t1 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2500, 1, 1, 0, 0, 1, 3, time.UTC)
+	mid, err := Midpoint[Time](New(ClosedEp(Time(t1)), OpenEp(Time(t2))), TimeMetric{})
+	assertEqual(t, nil, err)
+	assertEqual(t, t2.Sub(time.Time(mid))-time.Nanosecond, time.Time(mid).Sub(t1))
+}
+
+func TestMeasure(t *testing.T) {
+	i := func(lower, upper int) Interval[Int] {
+		return New(ClosedEp(Int(lower)), OpenEp(Int(upper)))
+	}
+	got, err := Measure[Int, uint64]([]Interval[Int]{i(1, 5), i(3, 8), i(10, 12), i(20, 20)}, IntMetric{})
+	assertEqual(t, nil, err)
+	assertEqual(t, uint64(9), got)
+
+	got, err = Measure[Int, uint64](nil, IntMetric{})
+	assertEqual(t, nil, err)
+	assertEqual(t, uint64(0), got)
+
+	_, err = Measure[Int, uint64]([]Interval[Int]{i(1, 5), New(ClosedEp(Int(7)), UnboundedEp[Int]())}, IntMetric{})
+	assertEqual(t, ErrUnbounded, err)
+}
@@ -0,0 +1,442 @@
+package interval
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+var (
+	_ Ordered[Native[int]] = Native[int]{}
+	_ Ordered[Int8]        = Int8(0)
+	_ Discrete[Int8]       = Int8(0)
+	_ Ordered[Int16]       = Int16(0)
+	_ Discrete[Int16]      = Int16(0)
+	_ Ordered[Int32]       = Int32(0)
+	_ Discrete[Int32]      = Int32(0)
+	_ Ordered[Int64]       = Int64(0)
+	_ Discrete[Int64]      = Int64(0)
+	_ Ordered[Uint]        = Uint(0)
+	_ Discrete[Uint]       = Uint(0)
+	_ Ordered[Uint8]       = Uint8(0)
+	_ Discrete[Uint8]      = Uint8(0)
+	_ Ordered[Uint16]      = Uint16(0)
+	_ Discrete[Uint16]     = Uint16(0)
+	_ Ordered[Uint32]      = Uint32(0)
+	_ Discrete[Uint32]     = Uint32(0)
+	_ Ordered[Uint64]      = Uint64(0)
+	_ Discrete[Uint64]     = Uint64(0)
+	_ Ordered[Float32]     = Float32(0)
+	_ Ordered[Float64]     = Float64(0)
+	_ Ordered[String]      = String("")
+	_ Comparable[String]   = String("")
+	_ Ordered[Bytes]       = Bytes(nil)
+	_ Comparable[Bytes]    = Bytes(nil)
+)
+
+// Native is a wrapper of any type satisfying cmp.Ordered.
+// It implements the Ordered interface.
+// Floating-point values are ordered as cmp.Compare does,
+// so NaN is equal to NaN and less than any other value.
+type Native[T cmp.Ordered] struct {
+	V T
+}
+
+// Equal checks if n is equal to n2.
+func (n Native[T]) Equal(n2 Native[T]) bool {
+	return cmp.Compare(n.V, n2.V) == 0
+}
+
+// LessThan checks if n is less than n2.
+func (n Native[T]) LessThan(n2 Native[T]) bool {
+	return cmp.Less(n.V, n2.V)
+}
+
+// String returns the default format of n.V.
+func (n Native[T]) String() string {
+	return fmt.Sprint(n.V)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (n Native[T]) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (n *Native[T]) UnmarshalText(text []byte) error {
+	return parseBasic(string(text), &n.V)
+}
+
+// MarshalJSON implements json.Marshaler.
+// Native is encoded as the JSON form of n.V.
+func (n Native[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (n *Native[T]) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &n.V)
+}
+
+// Int8 is a wrapper of int8.
+// It implements the Ordered and Discrete interfaces.
+type Int8 int8
+
+// Equal checks if i is equal to i2.
+func (i Int8) Equal(i2 Int8) bool {
+	return i == i2
+}
+
+// LessThan checks if i is less than i2.
+func (i Int8) LessThan(i2 Int8) bool {
+	return i < i2
+}
+
+// Next returns i+1. It returns false if i is the maximum int8.
+func (i Int8) Next() (Int8, bool) {
+	return next(i)
+}
+
+// Prev returns i-1. It returns false if i is the minimum int8.
+func (i Int8) Prev() (Int8, bool) {
+	return prev(i)
+}
+
+// Steps returns i2-i.
+func (i Int8) Steps(i2 Int8) uint64 {
+	return steps(i, i2)
+}
+
+// Int16 is a wrapper of int16.
+// It implements the Ordered and Discrete interfaces.
+type Int16 int16
+
+// Equal checks if i is equal to i2.
+func (i Int16) Equal(i2 Int16) bool {
+	return i == i2
+}
+
+// LessThan checks if i is less than i2.
+func (i Int16) LessThan(i2 Int16) bool {
+	return i < i2
+}
+
+// Next returns i+1. It returns false if i is the maximum int16.
+func (i Int16) Next() (Int16, bool) {
+	return next(i)
+}
+
+// Prev returns i-1. It returns false if i is the minimum int16.
+func (i Int16) Prev() (Int16, bool) {
+	return prev(i)
+}
+
+// Steps returns i2-i.
+func (i Int16) Steps(i2 Int16) uint64 {
+	return steps(i, i2)
+}
+
+// Int32 is a wrapper of int32.
+// It implements the Ordered and Discrete interfaces.
+type Int32 int32
+
+// Equal checks if i is equal to i2.
+func (i Int32) Equal(i2 Int32) bool {
+	return i == i2
+}
+
+// LessThan checks if i is less than i2.
+func (i Int32) LessThan(i2 Int32) bool {
+	return i < i2
+}
+
+// Next returns i+1. It returns false if i is the maximum int32.
+func (i Int32) Next() (Int32, bool) {
+	return next(i)
+}
+
+// Prev returns i-1. It returns false if i is the minimum int32.
+func (i Int32) Prev() (Int32, bool) {
+	return prev(i)
+}
+
+// Steps returns i2-i.
+func (i Int32) Steps(i2 Int32) uint64 {
+	return steps(i, i2)
+}
+
+// Int64 is a wrapper of int64.
+// It implements the Ordered and Discrete interfaces.
+type Int64 int64
+
+// Equal checks if i is equal to i2.
+func (i Int64) Equal(i2 Int64) bool {
+	return i == i2
+}
+
+// LessThan checks if i is less than i2.
+func (i Int64) LessThan(i2 Int64) bool {
+	return i < i2
+}
+
+// Next returns i+1. It returns false if i is the maximum int64.
+func (i Int64) Next() (Int64, bool) {
+	return next(i)
+}
+
+// Prev returns i-1. It returns false if i is the minimum int64.
+func (i Int64) Prev() (Int64, bool) {
+	return prev(i)
+}
+
+// Steps returns i2-i.
+func (i Int64) Steps(i2 Int64) uint64 {
+	return steps(i, i2)
+}
+
+// Uint is a wrapper of uint.
+// It implements the Ordered and Discrete interfaces.
+type Uint uint
+
+// Equal checks if u is equal to u2.
+func (u Uint) Equal(u2 Uint) bool {
+	return u == u2
+}
+
+// LessThan checks if u is less than u2.
+func (u Uint) LessThan(u2 Uint) bool {
+	return u < u2
+}
+
+// Next returns u+1. It returns false if u is the maximum uint.
+func (u Uint) Next() (Uint, bool) {
+	return next(u)
+}
+
+// Prev returns u-1. It returns false if u is the minimum uint.
+func (u Uint) Prev() (Uint, bool) {
+	return prev(u)
+}
+
+// Steps returns u2-u.
+func (u Uint) Steps(u2 Uint) uint64 {
+	return steps(u, u2)
+}
+
+// Uint8 is a wrapper of uint8.
+// It implements the Ordered and Discrete interfaces.
+type Uint8 uint8
+
+// Equal checks if u is equal to u2.
+func (u Uint8) Equal(u2 Uint8) bool {
+	return u == u2
+}
+
+// LessThan checks if u is less than u2.
+func (u Uint8) LessThan(u2 Uint8) bool {
+	return u < u2
+}
+
+// Next returns u+1. It returns false if u is the maximum uint8.
+func (u Uint8) Next() (Uint8, bool) {
+	return next(u)
+}
+
+// Prev returns u-1. It returns false if u is the minimum uint8.
+func (u Uint8) Prev() (Uint8, bool) {
+	return prev(u)
+}
+
+// Steps returns u2-u.
+func (u Uint8) Steps(u2 Uint8) uint64 {
+	return steps(u, u2)
+}
+
+// Uint16 is a wrapper of uint16.
+// It implements the Ordered and Discrete interfaces.
+type Uint16 uint16
+
+// Equal checks if u is equal to u2.
+func (u Uint16) Equal(u2 Uint16) bool {
+	return u == u2
+}
+
+// LessThan checks if u is less than u2.
+func (u Uint16) LessThan(u2 Uint16) bool {
+	return u < u2
+}
+
+// Next returns u+1. It returns false if u is the maximum uint16.
+func (u Uint16) Next() (Uint16, bool) {
+	return next(u)
+}
+
+// Prev returns u-1. It returns false if u is the minimum uint16.
+func (u Uint16) Prev() (Uint16, bool) {
+	return prev(u)
+}
+
+// Steps returns u2-u.
+func (u Uint16) Steps(u2 Uint16) uint64 {
+	return steps(u, u2)
+}
+
+// Uint32 is a wrapper of uint32.
+// It implements the Ordered and Discrete interfaces.
+type Uint32 uint32
+
+// Equal checks if u is equal to u2.
+func (u Uint32) Equal(u2 Uint32) bool {
+	return u == u2
+}
+
+// LessThan checks if u is less than u2.
+func (u Uint32) LessThan(u2 Uint32) bool {
+	return u < u2
+}
+
+// Next returns u+1. It returns false if u is the maximum uint32.
+func (u Uint32) Next() (Uint32, bool) {
+	return next(u)
+}
+
+// Prev returns u-1. It returns false if u is the minimum uint32.
+func (u Uint32) Prev() (Uint32, bool) {
+	return prev(u)
+}
+
+// Steps returns u2-u.
+func (u Uint32) Steps(u2 Uint32) uint64 {
+	return steps(u, u2)
+}
+
+// Uint64 is a wrapper of uint64.
+// It implements the Ordered and Discrete interfaces.
+type Uint64 uint64
+
+// Equal checks if u is equal to u2.
+func (u Uint64) Equal(u2 Uint64) bool {
+	return u == u2
+}
+
+// LessThan checks if u is less than u2.
+func (u Uint64) LessThan(u2 Uint64) bool {
+	return u < u2
+}
+
+// Next returns u+1. It returns false if u is the maximum uint64.
+func (u Uint64) Next() (Uint64, bool) {
+	return next(u)
+}
+
+// Prev returns u-1. It returns false if u is the minimum uint64.
+func (u Uint64) Prev() (Uint64, bool) {
+	return prev(u)
+}
+
+// Steps returns u2-u.
+func (u Uint64) Steps(u2 Uint64) uint64 {
+	return steps(u, u2)
+}
+
+// Float32 is a wrapper of float32.
+// It implements the Ordered interface.
+// Values are ordered as cmp.Compare does,
+// so NaN is equal to NaN and less than any other value.
+type Float32 float32
+
+// Equal checks if f is equal to f2.
+func (f Float32) Equal(f2 Float32) bool {
+	return cmp.Compare(f, f2) == 0
+}
+
+// LessThan checks if f is less than f2.
+func (f Float32) LessThan(f2 Float32) bool {
+	return cmp.Less(f, f2)
+}
+
+// Float64 is a wrapper of float64.
+// It implements the Ordered interface.
+// Values are ordered as cmp.Compare does,
+// so NaN is equal to NaN and less than any other value.
+// NewFloat64 builds intervals with infinite values as unbounded and rejects NaN.
+type Float64 float64
+
+// Equal checks if f is equal to f2.
+func (f Float64) Equal(f2 Float64) bool {
+	return cmp.Compare(f, f2) == 0
+}
+
+// LessThan checks if f is less than f2.
+func (f Float64) LessThan(f2 Float64) bool {
+	return cmp.Less(f, f2)
+}
+
+// String is a wrapper of string.
+// It implements the Ordered and Comparable interfaces.
+type String string
+
+// Equal checks if s is equal to s2.
+func (s String) Equal(s2 String) bool {
+	return s == s2
+}
+
+// LessThan checks if s is less than s2.
+func (s String) LessThan(s2 String) bool {
+	return s < s2
+}
+
+// Compare compares s and s2 as strings.Compare does.
+func (s String) Compare(s2 String) int {
+	return strings.Compare(string(s), string(s2))
+}
+
+// Bytes is a wrapper of []byte.
+// It implements the Ordered and Comparable interfaces, ordering values lexicographically.
+type Bytes []byte
+
+// Equal checks if b is equal to b2.
+func (b Bytes) Equal(b2 Bytes) bool {
+	return bytes.Equal(b, b2)
+}
+
+// LessThan checks if b is less than b2.
+func (b Bytes) LessThan(b2 Bytes) bool {
+	return bytes.Compare(b, b2) < 0
+}
+
+// Compare compares b and b2 as bytes.Compare does.
+func (b Bytes) Compare(b2 Bytes) int {
+	return bytes.Compare(b, b2)
+}
+
+// String returns b as a string.
+func (b Bytes) String() string {
+	return string(b)
+}
+
+// integer is a constraint for the underlying types of the integer wrappers.
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+func next[T integer](v T) (T, bool) {
+	if n := v + 1; n > v {
+		return n, true
+	}
+	return v, false
+}
+
+func prev[T integer](v T) (T, bool) {
+	if p := v - 1; p < v {
+		return p, true
+	}
+	return v, false
+}
+
+func steps[T integer](v, to T) uint64 {
+	return uint64(to) - uint64(v)
+}
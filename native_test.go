@@ -0,0 +1,161 @@
+package interval
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func testOrderedSuite[T Ordered[T]](t *testing.T, v1, v2, v3, v4 T) {
+	t.Run("NewEndpoint", func(t *testing.T) {
+		testNewEndpoint(t, v1)
+	})
+	t.Run("IsEmpty", func(t *testing.T) {
+		testIsEmpty(t, v1, v3)
+	})
+	t.Run("Contains", func(t *testing.T) {
+		testContains(t, v1, v3, v4)
+	})
+	t.Run("CompareInterval", func(t *testing.T) {
+		testCompareInterval(t, v1, v2, v3, v4)
+	})
+	t.Run("Intersect", func(t *testing.T) {
+		testIntersect(t, v1, v2, v3, v4)
+	})
+	t.Run("Difference", func(t *testing.T) {
+		testDifference(t, v1, v2, v3, v4)
+	})
+	t.Run("Relation", func(t *testing.T) {
+		testRelation(t, v1, v2, v3, v4)
+	})
+}
+
+func TestNative(t *testing.T) {
+	t.Run("Native[int]", func(t *testing.T) {
+		testOrderedSuite(t, Native[int]{1}, Native[int]{3}, Native[int]{5}, Native[int]{7})
+	})
+	t.Run("Native[string]", func(t *testing.T) {
+		testOrderedSuite(t, Native[string]{"a"}, Native[string]{"b"}, Native[string]{"c"}, Native[string]{"d"})
+	})
+	t.Run("Int8", func(t *testing.T) {
+		testOrderedSuite(t, Int8(-7), Int8(-5), Int8(-3), Int8(-1))
+	})
+	t.Run("Int16", func(t *testing.T) {
+		testOrderedSuite(t, Int16(1), Int16(3), Int16(5), Int16(7))
+	})
+	t.Run("Int32", func(t *testing.T) {
+		testOrderedSuite(t, Int32(1), Int32(3), Int32(5), Int32(7))
+	})
+	t.Run("Int64", func(t *testing.T) {
+		testOrderedSuite(t, Int64(1), Int64(3), Int64(5), Int64(7))
+	})
+	t.Run("Uint", func(t *testing.T) {
+		testOrderedSuite(t, Uint(1), Uint(3), Uint(5), Uint(7))
+	})
+	t.Run("Uint8", func(t *testing.T) {
+		testOrderedSuite(t, Uint8(1), Uint8(3), Uint8(5), Uint8(7))
+	})
+	t.Run("Uint16", func(t *testing.T) {
+		testOrderedSuite(t, Uint16(1), Uint16(3), Uint16(5), Uint16(7))
+	})
+	t.Run("Uint32", func(t *testing.T) {
+		testOrderedSuite(t, Uint32(1), Uint32(3), Uint32(5), Uint32(7))
+	})
+	t.Run("Uint64", func(t *testing.T) {
+		testOrderedSuite(t, Uint64(1), Uint64(3), Uint64(5), Uint64(7))
+	})
+	t.Run("Float32", func(t *testing.T) {
+		testOrderedSuite(t, Float32(0.5), Float32(1), Float32(1.5), Float32(2))
+	})
+	t.Run("Float64", func(t *testing.T) {
+		testOrderedSuite(t, Float64(-1), Float64(0), Float64(0.5), Float64(math.Inf(1)))
+	})
+	t.Run("String", func(t *testing.T) {
+		testOrderedSuite(t, String("a"), String("ab"), String("b"), String("c"))
+	})
+}
+
+func TestBytes(t *testing.T) {
+	i := New(ClosedEp(Bytes("a")), OpenEp(Bytes("b")))
+	assertEqual(t, true, i.Contains(Bytes("a")))
+	assertEqual(t, true, i.Contains(Bytes("azz")))
+	assertEqual(t, false, i.Contains(Bytes("b")))
+	assertEqual(t, true, i.Overlaps(New(ClosedEp(Bytes("ab")), UnboundedEp[Bytes]())))
+	assertEqual(t, "[a, b)", i.String())
+
+	var got Interval[Bytes]
+	if err := got.UnmarshalText([]byte("[a, b)")); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, true, i.Equal(got))
+}
+
+func TestNaN(t *testing.T) {
+	nan := Float64(math.NaN())
+	assertEqual(t, true, nan.Equal(nan))
+	assertEqual(t, true, nan.LessThan(Float64(math.Inf(-1))))
+	assertEqual(t, false, Float64(0).LessThan(nan))
+
+	n := Native[float64]{math.NaN()}
+	assertEqual(t, true, n.Equal(n))
+	assertEqual(t, true, n.LessThan(Native[float64]{0}))
+}
+
+func TestIntegerDiscrete(t *testing.T) {
+	n, ok := Int8(math.MaxInt8 - 1).Next()
+	assertEqual(t, Int8(math.MaxInt8), n)
+	assertEqual(t, true, ok)
+	_, ok = Int8(math.MaxInt8).Next()
+	assertEqual(t, false, ok)
+	_, ok = Int8(math.MinInt8).Prev()
+	assertEqual(t, false, ok)
+	_, ok = Uint8(0).Prev()
+	assertEqual(t, false, ok)
+	p, ok := Uint64(1).Prev()
+	assertEqual(t, Uint64(0), p)
+	assertEqual(t, true, ok)
+	_, ok = Uint64(math.MaxUint64).Next()
+	assertEqual(t, false, ok)
+
+	assertEqual(t, uint64(255), Int8(math.MinInt8).Steps(Int8(math.MaxInt8)))
+	assertEqual(t, uint64(math.MaxUint64), Uint64(0).Steps(Uint64(math.MaxUint64)))
+
+	c, ok := New(OpenEp(Uint8(1)), ClosedEp(Uint8(math.MaxUint8))).Count()
+	assertEqual(t, uint64(254), c)
+	assertEqual(t, true, ok)
+	assertEqual(t, true, New(OpenEp(Int32(1)), OpenEp(Int32(2))).IsEmpty())
+}
+
+func TestNativeEncoding(t *testing.T) {
+	i := New(ClosedEp(Native[float64]{1.5}), UnboundedEp[Native[float64]]())
+	b, err := json.Marshal(i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, `{"lower":{"value":1.5,"closed":true},"upper":{"unbounded":true}}`, string(b))
+	var got Interval[Native[float64]]
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, i, got)
+
+	text, err := i.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "[1.5, +inf)", string(text))
+	got = Interval[Native[float64]]{}
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, i, got)
+
+	var u Interval[Uint16]
+	if err := u.UnmarshalText([]byte("[1, 70000]")); err == nil {
+		t.Error("want error for overflow, got nil")
+	}
+	if err := u.Scan("[1,7)"); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, New(ClosedEp(Uint16(1)), OpenEp(Uint16(7))), u)
+}
@@ -0,0 +1,122 @@
+package interval
+
+import (
+	"errors"
+	"iter"
+	"time"
+)
+
+// ErrStep indicates that a step does not move values forward.
+var ErrStep = errors.New("interval: step does not move forward")
+
+// Points returns an iterator over the points lower, lower+step, lower+2*step, ...
+// contained in interval in ascending order, moving values by a.Add.
+// An open lower endpoint is skipped, and discrete intervals are stepped from their first points,
+// so the points of (3, 10) by 2 are 4, 6 and 8.
+// Iteration stops early if a.Add saturates at the end of T.
+//
+// It returns ErrUnbounded for unbounded intervals, which may be limited by Intersect beforehand,
+// and ErrStep if step does not move forward. Empty intervals have no points.
+func Points[T Ordered[T], D any](i Interval[T], step D, a Affine[T, D]) (iter.Seq[T], error) {
+	compare := comparer[T]()
+	return walk(i, true, step, a, func(_, v T, _ int) (T, bool) {
+		return moved(v, a.Add(v, step), step, a.Add, a.Sub, compare)
+	})
+}
+
+// Reverse returns an iterator over the points upper, upper-step, upper-2*step, ...
+// contained in interval in descending order, moving values by a.Sub.
+// It is the mirror of Points, so the points of (3, 10) by 2 are 9, 7 and 5.
+func Reverse[T Ordered[T], D any](i Interval[T], step D, a Affine[T, D]) (iter.Seq[T], error) {
+	compare := comparer[T]()
+	return walk(i, false, step, a, func(_, v T, _ int) (T, bool) {
+		return moved(v, a.Sub(v, step), step, a.Sub, a.Add, compare)
+	})
+}
+
+// CalendarPoints is Points by a calendar period, where the k-th point is lower plus k times period
+// instead of the previous point plus period, so monthly steps keep the day of month:
+// the points from 2020-01-31 by P1M are 2020-01-31, 2020-02-29, 2020-03-31 and so on.
+func CalendarPoints(i Interval[Time], p Period) (iter.Seq[Time], error) {
+	return walk(i, true, p, TimeCalendar{}, func(start, _ Time, k int) (Time, bool) {
+		return Time(p.mul(k).AddTo(time.Time(start))), true
+	})
+}
+
+// CalendarReverse is Reverse by a calendar period, where the k-th point is upper minus k times period.
+func CalendarReverse(i Interval[Time], p Period) (iter.Seq[Time], error) {
+	return walk(i, false, p, TimeCalendar{}, func(start, _ Time, k int) (Time, bool) {
+		return Time(p.mul(k).SubFrom(time.Time(start))), true
+	})
+}
+
+// walk returns an iterator over the points of interval from its lower endpoint if forward is true,
+// or from its upper endpoint otherwise. next returns the k-th point from start given the previous point v,
+// or false if there is no such point.
+func walk[T Ordered[T], D any](i Interval[T], forward bool, step D, a Affine[T, D], next func(start, v T, k int) (T, bool)) (iter.Seq[T], error) {
+	d := domainOf[T]()
+	// step discrete intervals from their first or last points
+	if i = d.canonical(i); i.isEmpty(d.compare) {
+		return func(func(T) bool) {}, nil
+	}
+	if !i.Lower.Bounded() || !i.Upper.Bounded() {
+		return nil, ErrUnbounded
+	}
+	if d.prev != nil && !i.Upper.Closed {
+		if p, ok := d.prev(i.Upper.Value); ok {
+			i.Upper = ClosedEp(p)
+		}
+	}
+	compare := d.compare
+	start, closed := i.Lower.Value, i.Lower.Closed
+	if !forward {
+		start, closed = i.Upper.Value, i.Upper.Closed
+	}
+	// either may be saturated at the end of T
+	if compare.cmp(a.Add(start, step), start) <= 0 && compare.cmp(a.Sub(start, step), start) >= 0 {
+		return nil, ErrStep
+	}
+
+	return func(yield func(T) bool) {
+		v := start
+		for k := 0; ; k++ {
+			if k > 0 {
+				prev := v
+				var ok bool
+				if v, ok = next(start, v, k); !ok {
+					return
+				}
+				if c := compare.cmp(v, prev); (forward && c <= 0) || (!forward && c >= 0) {
+					return
+				}
+			} else if !closed {
+				continue
+			}
+			if (forward && i.endsBefore(v, compare)) || (!forward && i.startsAfter(v, compare)) {
+				return
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}, nil
+}
+
+// moved returns n, the value v moved by step, and false if the move saturated,
+// that is, n can move no further and is not step away from v.
+func moved[T Ordered[T], D any](v, n T, step D, move, back func(T, D) T, compare comparator[T]) (T, bool) {
+	if compare.cmp(move(n, step), n) == 0 && compare.cmp(back(n, step), v) != 0 {
+		return n, false
+	}
+	return n, true
+}
+
+// startsAfter returns true if the lower endpoint of interval lies above the point.
+func (i Interval[T]) startsAfter(p T, compare comparator[T]) bool {
+	l := i.Lower
+	if l.Unbounded {
+		return false
+	}
+	c := compare.cmp(l.Value, p)
+	return c > 0 || (c == 0 && !l.Closed)
+}
@@ -0,0 +1,104 @@
+package interval
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestPoints(t *testing.T) {
+	cases := []struct {
+		name    string
+		i       Interval[Int]
+		step    int
+		points  []Int
+		reverse []Int
+	}{
+		{"half-open", ClosedOpen(Int(3), Int(10)), 1, []Int{3, 4, 5, 6, 7, 8, 9}, []Int{9, 8, 7, 6, 5, 4, 3}},
+		{"open", Open(Int(3), Int(10)), 2, []Int{4, 6, 8}, []Int{9, 7, 5}},
+		{"closed", Closed(Int(3), Int(10)), 3, []Int{3, 6, 9}, []Int{10, 7, 4}},
+		{"singleton", Singleton(Int(3)), 5, []Int{3}, []Int{3}},
+		{"empty", Open(Int(3), Int(4)), 1, nil, nil},
+		{"saturated", Closed(Int(math.MaxInt-2), Int(math.MaxInt)), 2, []Int{math.MaxInt - 2, math.MaxInt}, []Int{math.MaxInt, math.MaxInt - 2}},
+		{"at the minimum", Closed(Int(math.MinInt), Int(math.MinInt+1)), 5, []Int{math.MinInt}, []Int{math.MinInt + 1}},
+		{"beyond the maximum", Closed(Int(math.MaxInt-2), Int(math.MaxInt)), 5, []Int{math.MaxInt - 2}, []Int{math.MaxInt}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			seq, err := Points(c.i, c.step, IntMetric{})
+			assertEqual(t, nil, err)
+			assertDeepEqual(t, c.points, slices.Collect(seq))
+
+			seq, err = Reverse(c.i, c.step, IntMetric{})
+			assertEqual(t, nil, err)
+			assertDeepEqual(t, c.reverse, slices.Collect(seq))
+		})
+	}
+
+	_, err := Points(AtLeast(Int(3)), 1, IntMetric{})
+	assertEqual(t, ErrUnbounded, err)
+	_, err = Reverse(AtMost(Int(3)), 1, IntMetric{})
+	assertEqual(t, ErrUnbounded, err)
+	_, err = Points(Closed(Int(3), Int(5)), 0, IntMetric{})
+	assertEqual(t, ErrStep, err)
+	_, err = Reverse(Closed(Int(3), Int(5)), -1, IntMetric{})
+	assertEqual(t, ErrStep, err)
+
+	// stop early
+	seq, _ := Points(Closed(Int(0), Int(100)), 1, IntMetric{})
+	var res []Int
+	for p := range seq {
+		if p == 2 {
+			break
+		}
+		res = append(res, p)
+	}
+	assertDeepEqual(t, []Int{0, 1}, res)
+}
+
+func TestPointsTime(t *testing.T) {
+	day := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	i := ClosedOpen(Time(day), Time(day.AddDate(0, 0, 1)))
+
+	seq, err := Points(i, 15*time.Minute, TimeMetric{})
+	assertEqual(t, nil, err)
+	ticks := slices.Collect(seq)
+	assertEqual(t, 96, len(ticks))
+	assertEqual(t, Time(day), ticks[0])
+	assertEqual(t, Time(day.Add(23*time.Hour+45*time.Minute)), ticks[95])
+
+	seq, err = Reverse(OpenClosed(Time(day), Time(day.Add(time.Hour))), 20*time.Minute, TimeMetric{})
+	assertEqual(t, nil, err)
+	assertDeepEqual(t, []Time{Time(day.Add(time.Hour)), Time(day.Add(40 * time.Minute)), Time(day.Add(20 * time.Minute))}, slices.Collect(seq))
+
+	_, err = Points(i, -time.Minute, TimeMetric{})
+	assertEqual(t, ErrStep, err)
+}
+
+func TestCalendarPoints(t *testing.T) {
+	date := func(y int, m time.Month, d int) Time {
+		return Time(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
+	}
+	monthly := Period{Months: 1}
+	i := Closed(date(2020, 1, 31), date(2020, 5, 31))
+
+	seq, err := CalendarPoints(i, monthly)
+	assertEqual(t, nil, err)
+	assertDeepEqual(t, []Time{date(2020, 1, 31), date(2020, 2, 29), date(2020, 3, 31), date(2020, 4, 30), date(2020, 5, 31)}, slices.Collect(seq))
+
+	// stepping from the previous point drifts to the 29th
+	seq, err = Points(i, monthly, TimeCalendar{})
+	assertEqual(t, nil, err)
+	assertDeepEqual(t, []Time{date(2020, 1, 31), date(2020, 2, 29), date(2020, 3, 29), date(2020, 4, 29), date(2020, 5, 29)}, slices.Collect(seq))
+
+	seq, err = CalendarReverse(ClosedOpen(date(2019, 12, 31), date(2020, 3, 31)), monthly)
+	assertEqual(t, nil, err)
+	assertDeepEqual(t, []Time{date(2020, 2, 29), date(2020, 1, 31), date(2019, 12, 31)}, slices.Collect(seq))
+
+	_, err = CalendarPoints(GreaterThan(date(2020, 1, 1)), monthly)
+	assertEqual(t, ErrUnbounded, err)
+	_, err = CalendarPoints(i, Period{})
+	assertEqual(t, ErrStep, err)
+}
@@ -0,0 +1,149 @@
+package interval
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// The scheduling functions below return indices of given intervals.
+// Intervals are regarded as compatible if they share no point as with Interval.Overlaps,
+// so [1, 3) and [3, 5] are compatible. Empty intervals are never selected.
+
+// MaxIndependent returns a largest set of pairwise compatible intervals,
+// chosen greedily by the earliest upper endpoint, in ascending order.
+func MaxIndependent[T Ordered[T]](intervals []Interval[T]) []int {
+	compare := comparer[T]()
+	order, canonical := byUpper(intervals, compare)
+	var res []int
+	var last Interval[T]
+	for _, k := range order {
+		if i := canonical[k]; len(res) == 0 || compare.ep(last.Upper, true, i.Lower, false) <= 0 {
+			res = append(res, k)
+			last = i
+		}
+	}
+	return res
+}
+
+// MaxWeightIndependent returns a set of pairwise compatible intervals
+// whose total weight is the greatest, in ascending order, and the total weight.
+// weights[k] is the weight of intervals[k], and intervals of non-positive weights are never selected.
+func MaxWeightIndependent[T Ordered[T], W Number](intervals []Interval[T], weights []W) ([]int, W) {
+	compare := comparer[T]()
+	order, canonical := byUpper(intervals, compare)
+
+	// best[j] is the greatest total weight of the first j intervals in order,
+	// and prev[j] is the number of the intervals ending before the j-th one starts.
+	best := make([]W, len(order)+1)
+	prev := make([]int, len(order))
+	for j, k := range order {
+		prev[j] = sort.Search(j, func(p int) bool {
+			return compare.ep(canonical[order[p]].Upper, true, canonical[k].Lower, false) > 0
+		})
+		best[j+1] = best[j]
+		if w := weights[k] + best[prev[j]]; weights[k] > 0 && w > best[j+1] {
+			best[j+1] = w
+		}
+	}
+
+	var res []int
+	for j := len(order); j > 0; {
+		if best[j] == best[j-1] {
+			j--
+			continue
+		}
+		res = append(res, order[j-1])
+		j = prev[j-1]
+	}
+	for a, b := 0, len(res)-1; a < b; a, b = a+1, b-1 {
+		res[a], res[b] = res[b], res[a]
+	}
+	return res, best[len(order)]
+}
+
+// Partition divides intervals into the fewest lanes of pairwise compatible intervals,
+// like assigning bookings to rooms. Each lane lists intervals in ascending order,
+// and the number of lanes equals the depth returned by MaxDepth.
+func Partition[T Ordered[T]](intervals []Interval[T]) [][]int {
+	compare := comparer[T]()
+	order, canonical := nonEmpty(intervals)
+	sort.SliceStable(order, func(a, b int) bool {
+		return compare.ep(canonical[order[a]].Lower, false, canonical[order[b]].Lower, false) < 0
+	})
+
+	var lanes [][]int
+	h := &laneHeap[T]{compare: compare}
+	for _, k := range order {
+		i := canonical[k]
+		// reuse the lane which became free first
+		if h.Len() > 0 && compare.ep(h.ends[0].upper, true, i.Lower, false) <= 0 {
+			l := h.ends[0].lane
+			lanes[l] = append(lanes[l], k)
+			h.ends[0].upper = i.Upper
+			heap.Fix(h, 0)
+			continue
+		}
+		lanes = append(lanes, []int{k})
+		heap.Push(h, laneEnd[T]{upper: i.Upper, lane: len(lanes) - 1})
+	}
+	return lanes
+}
+
+// nonEmpty returns the indices of non-empty intervals
+// and the canonical forms of all intervals.
+func nonEmpty[T Ordered[T]](intervals []Interval[T]) ([]int, []Interval[T]) {
+	d := domainOf[T]()
+	var order []int
+	canonical := make([]Interval[T], len(intervals))
+	for k, i := range intervals {
+		if c := d.canonical(i); !c.isEmpty(d.compare) {
+			order = append(order, k)
+			canonical[k] = c
+		}
+	}
+	return order, canonical
+}
+
+// byUpper returns the indices of non-empty intervals ordered by upper endpoints,
+// and the canonical forms of all intervals.
+func byUpper[T Ordered[T]](intervals []Interval[T], compare comparator[T]) ([]int, []Interval[T]) {
+	order, canonical := nonEmpty(intervals)
+	sort.SliceStable(order, func(a, b int) bool {
+		return compare.ep(canonical[order[a]].Upper, true, canonical[order[b]].Upper, true) < 0
+	})
+	return order, canonical
+}
+
+// laneEnd is the upper endpoint of the last interval of a lane.
+type laneEnd[T Ordered[T]] struct {
+	upper Endpoint[T]
+	lane  int
+}
+
+// laneHeap is a min-heap of lane ends by upper endpoints.
+type laneHeap[T Ordered[T]] struct {
+	ends    []laneEnd[T]
+	compare comparator[T]
+}
+
+func (h *laneHeap[T]) Len() int {
+	return len(h.ends)
+}
+
+func (h *laneHeap[T]) Less(a, b int) bool {
+	return h.compare.ep(h.ends[a].upper, true, h.ends[b].upper, true) < 0
+}
+
+func (h *laneHeap[T]) Swap(a, b int) {
+	h.ends[a], h.ends[b] = h.ends[b], h.ends[a]
+}
+
+func (h *laneHeap[T]) Push(x any) {
+	h.ends = append(h.ends, x.(laneEnd[T]))
+}
+
+func (h *laneHeap[T]) Pop() any {
+	e := h.ends[len(h.ends)-1]
+	h.ends = h.ends[:len(h.ends)-1]
+	return e
+}
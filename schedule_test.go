@@ -0,0 +1,133 @@
+package interval
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMaxIndependent(t *testing.T) {
+	f := func(v float64) Float64 { return Float64(v) }
+	cases := []struct {
+		name      string
+		intervals []Interval[Float64]
+		want      []int
+	}{
+		{"none", nil, nil},
+		{"earliest finish", []Interval[Float64]{Closed(f(0), f(10)), Closed(f(1), f(2)), Closed(f(3), f(4))}, []int{1, 2}},
+		{"touching", []Interval[Float64]{ClosedOpen(f(1), f(3)), Closed(f(3), f(5))}, []int{0, 1}},
+		{"sharing a point", []Interval[Float64]{Closed(f(1), f(3)), Closed(f(3), f(5))}, []int{0}},
+		{"open at a point", []Interval[Float64]{Open(f(1), f(3)), Open(f(3), f(5))}, []int{0, 1}},
+		{"unbounded", []Interval[Float64]{AtLeast(f(5)), LessThan(f(2)), Closed(f(2), f(4))}, []int{1, 2, 0}},
+		{"empty", []Interval[Float64]{Open(f(1), f(1)), Closed(f(1), f(2))}, []int{1}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertDeepEqual(t, c.want, MaxIndependent(c.intervals))
+		})
+	}
+
+	// adjacent in the canonical forms
+	assertDeepEqual(t, []int{0, 1}, MaxIndependent([]Interval[Int]{Closed(Int(1), Int(2)), Closed(Int(3), Int(4))}))
+}
+
+func TestMaxWeightIndependent(t *testing.T) {
+	f := func(v float64) Float64 { return Float64(v) }
+	intervals := []Interval[Float64]{
+		ClosedOpen(f(0), f(3)),
+		ClosedOpen(f(1), f(4)),
+		ClosedOpen(f(3), f(6)),
+		ClosedOpen(f(4), f(7)),
+		Closed(f(0), f(7)),
+	}
+	got, w := MaxWeightIndependent(intervals, []int{2, 5, 2, 4, 8})
+	assertDeepEqual(t, []int{1, 3}, got)
+	assertEqual(t, 9, w)
+
+	got, w = MaxWeightIndependent(intervals, []int{2, 5, 2, 4, 10})
+	assertDeepEqual(t, []int{4}, got)
+	assertEqual(t, 10, w)
+
+	got, w = MaxWeightIndependent(intervals, []int{-1, 0, 0, -2, -3})
+	assertDeepEqual(t, []int(nil), got)
+	assertEqual(t, 0, w)
+}
+
+// TestMaxWeightIndependentRandom compares the result with the brute force search.
+func TestMaxWeightIndependentRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 200; n++ {
+		intervals := make([]Interval[Int], r.Intn(10))
+		weights := make([]int, len(intervals))
+		for k := range intervals {
+			lower := Int(r.Intn(20))
+			intervals[k] = New(Endpoint[Int]{Value: lower, Closed: r.Intn(2) == 0}, Endpoint[Int]{Value: lower + Int(r.Intn(6)), Closed: r.Intn(2) == 0})
+			weights[k] = r.Intn(10)
+		}
+
+		want := 0
+		for set := 0; set < 1<<len(intervals); set++ {
+			sum, ok := 0, true
+			for k := range intervals {
+				if set&(1<<k) == 0 || intervals[k].IsEmpty() {
+					continue
+				}
+				sum += weights[k]
+				for k2 := 0; k2 < k; k2++ {
+					if set&(1<<k2) != 0 && intervals[k].Overlaps(intervals[k2]) {
+						ok = false
+					}
+				}
+			}
+			if ok && sum > want {
+				want = sum
+			}
+		}
+
+		got, w := MaxWeightIndependent(intervals, weights)
+		assertEqual(t, want, w)
+		sum := 0
+		for a, k := range got {
+			sum += weights[k]
+			for _, k2 := range got[:a] {
+				if intervals[k].Overlaps(intervals[k2]) {
+					t.Fatalf("%v and %v overlap", intervals[k], intervals[k2])
+				}
+			}
+		}
+		assertEqual(t, w, sum)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	f := func(v float64) Float64 { return Float64(v) }
+	intervals := []Interval[Float64]{
+		ClosedOpen(f(9), f(10)),
+		ClosedOpen(f(9), f(12)),
+		ClosedOpen(f(10), f(11)),
+		Closed(f(11), f(12)),
+		Closed(f(12), f(13)),
+		Open(f(0), f(0)),
+	}
+	assertDeepEqual(t, [][]int{{0, 2, 3}, {1, 4}}, Partition(intervals))
+	assertDeepEqual(t, [][]int(nil), Partition[Int](nil))
+
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 100; n++ {
+		intervals := make([]Interval[Int], r.Intn(30))
+		for k := range intervals {
+			lower := Int(r.Intn(50))
+			intervals[k] = New(Endpoint[Int]{Value: lower, Closed: r.Intn(2) == 0}, Endpoint[Int]{Value: lower + Int(r.Intn(10)), Closed: r.Intn(2) == 0})
+		}
+		lanes := Partition(intervals)
+		depth, _ := MaxDepth(intervals)
+		assertEqual(t, depth, len(lanes))
+		for _, lane := range lanes {
+			for a := 1; a < len(lane); a++ {
+				if !intervals[lane[a-1]].Before(intervals[lane[a]]) {
+					t.Fatalf("%v is not before %v", intervals[lane[a-1]], intervals[lane[a]])
+				}
+			}
+		}
+	}
+}
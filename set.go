@@ -0,0 +1,135 @@
+package interval
+
+import "sort"
+
+// IntervalSet represents a union of intervals.
+// It is kept as a sorted list of disjoint, non-empty intervals,
+// where intervals sharing a point or touching each other are merged.
+// The zero value of IntervalSet is an empty set.
+type IntervalSet[T Ordered[T]] struct {
+	intervals []Interval[T]
+}
+
+// NewSet returns a set containing the points of given intervals.
+func NewSet[T Ordered[T]](intervals ...Interval[T]) IntervalSet[T] {
+	return IntervalSet[T]{
+		intervals: normalize(append([]Interval[T](nil), intervals...)),
+	}
+}
+
+// Intervals returns the disjoint intervals of set in ascending order.
+func (s IntervalSet[T]) Intervals() []Interval[T] {
+	return append([]Interval[T](nil), s.intervals...)
+}
+
+// Len returns the number of disjoint intervals in set.
+func (s IntervalSet[T]) Len() int {
+	return len(s.intervals)
+}
+
+// IsEmpty returns true if no points are contained in set.
+func (s IntervalSet[T]) IsEmpty() bool {
+	return len(s.intervals) == 0
+}
+
+// Add adds the points of interval to set.
+func (s *IntervalSet[T]) Add(i Interval[T]) {
+	s.intervals = normalize(append(s.Intervals(), i))
+}
+
+// Remove removes the points of interval from set.
+func (s *IntervalSet[T]) Remove(i Interval[T]) {
+	*s = s.Difference(NewSet(i))
+}
+
+// Contains returns true if set contains the point with given value.
+func (s IntervalSet[T]) Contains(p T) bool {
+	_, ok := Search(s.intervals, p)
+	return ok
+}
+
+// Union returns the set of points contained in either set.
+func (s IntervalSet[T]) Union(s2 IntervalSet[T]) IntervalSet[T] {
+	return NewSet(append(s.Intervals(), s2.intervals...)...)
+}
+
+// Intersect returns the set of points contained in both sets.
+func (s IntervalSet[T]) Intersect(s2 IntervalSet[T]) IntervalSet[T] {
+	var res []Interval[T]
+	d := domainOf[T]()
+	compare := d.compare
+	for k, k2 := 0, 0; k < len(s.intervals) && k2 < len(s2.intervals); {
+		i, i2 := s.intervals[k], s2.intervals[k2]
+		if x := i.intersect(i2, d); !d.empty(x) {
+			res = append(res, x)
+		}
+		// advance the one which ends first
+		if compare.ep(i.Upper, true, i2.Upper, true) < 0 {
+			k++
+		} else {
+			k2++
+		}
+	}
+	return IntervalSet[T]{intervals: res}
+}
+
+// Difference returns the set of points contained in set but not in other set.
+func (s IntervalSet[T]) Difference(s2 IntervalSet[T]) IntervalSet[T] {
+	return s.Intersect(s2.Complement())
+}
+
+// Complement returns the set of points not contained in set.
+func (s IntervalSet[T]) Complement() IntervalSet[T] {
+	var res []Interval[T]
+	lower := UnboundedEp[T]()
+	for _, i := range s.intervals {
+		if i.Lower.Bounded() {
+			res = append(res, New(lower, i.Lower.flip()))
+		}
+		if i.Upper.Unbounded {
+			return IntervalSet[T]{intervals: res}
+		}
+		lower = i.Upper.flip()
+	}
+	res = append(res, New(lower, UnboundedEp[T]()))
+	return IntervalSet[T]{intervals: res}
+}
+
+// normalize sorts intervals and merges the connected ones.
+// Empty intervals are dropped.
+func normalize[T Ordered[T]](intervals []Interval[T]) []Interval[T] {
+	d := domainOf[T]()
+	res := intervals[:0]
+	for _, i := range intervals {
+		if !d.empty(i) {
+			res = append(res, i)
+		}
+	}
+	if len(res) == 0 {
+		return nil
+	}
+	sort.Slice(res, func(a, b int) bool {
+		return d.compare.ep(res[a].Lower, false, res[b].Lower, false) < 0
+	})
+
+	merged := res[:1]
+	for _, i := range res[1:] {
+		last := &merged[len(merged)-1]
+		if d.canonical(*last).connected(d.canonical(i), d.compare) {
+			*last = last.hull(i, d)
+		} else {
+			merged = append(merged, i)
+		}
+	}
+	return merged
+}
+
+// endsBefore returns true if the upper endpoint of interval lies below the point.
+func (i Interval[T]) endsBefore(p T, compare comparator[T]) bool {
+	u := i.Upper
+	if u.Unbounded {
+		return false
+	}
+	c := compare.cmp(u.Value, p)
+	return c < 0 || (c == 0 && !u.Closed)
+}
@@ -0,0 +1,191 @@
+package interval
+
+import "testing"
+
+func TestNewSet(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	cases := []struct {
+		name      string
+		intervals []Interval[Int]
+		want      []Interval[Int]
+	}{
+		{
+			name:      "no intervals",
+			intervals: nil,
+			want:      nil,
+		},
+		{
+			name:      "empty intervals are dropped",
+			intervals: []Interval[Int]{{}, New(OpenEp(Int(1)), OpenEp(Int(1)))},
+			want:      nil,
+		},
+		{
+			name: "sorted",
+			intervals: []Interval[Int]{
+				New(ClosedEp(Int(5)), ClosedEp(Int(7))),
+				New(ClosedEp(Int(1)), ClosedEp(Int(3))),
+			},
+			want: []Interval[Int]{
+				New(ClosedEp(Int(1)), ClosedEp(Int(3))),
+				New(ClosedEp(Int(5)), ClosedEp(Int(7))),
+			},
+		},
+		{
+			name: "overlapping intervals are merged",
+			intervals: []Interval[Int]{
+				New(ClosedEp(Int(1)), ClosedEp(Int(4))),
+				New(OpenEp(Int(3)), OpenEp(Int(7))),
+				New(ClosedEp(Int(2)), ClosedEp(Int(3))),
+			},
+			want: []Interval[Int]{
+				New(ClosedEp(Int(1)), OpenEp(Int(7))),
+			},
+		},
+		{
+			name: "touching intervals are merged",
+			intervals: []Interval[Int]{
+				New(ClosedEp(Int(3)), ClosedEp(Int(5))),
+				New(ClosedEp(Int(1)), OpenEp(Int(3))),
+			},
+			want: []Interval[Int]{
+				New(ClosedEp(Int(1)), ClosedEp(Int(5))),
+			},
+		},
+		{
+			name: "intervals with a gap of one point are not merged",
+			intervals: []Interval[Int]{
+				New(ClosedEp(Int(1)), OpenEp(Int(3))),
+				New(OpenEp(Int(3)), ClosedEp(Int(5))),
+			},
+			want: []Interval[Int]{
+				New(ClosedEp(Int(1)), OpenEp(Int(3))),
+				New(OpenEp(Int(3)), ClosedEp(Int(5))),
+			},
+		},
+		{
+			name: "discrete intervals without a point between them are merged",
+			intervals: []Interval[Int]{
+				New(ClosedEp(Int(1)), ClosedEp(Int(2))),
+				New(ClosedEp(Int(3)), ClosedEp(Int(4))),
+			},
+			want: []Interval[Int]{
+				New(ClosedEp(Int(1)), ClosedEp(Int(4))),
+			},
+		},
+		{
+			name: "unbounded",
+			intervals: []Interval[Int]{
+				New(ClosedEp(Int(1)), unbounded),
+				New(unbounded, ClosedEp(Int(1))),
+			},
+			want: []Interval[Int]{
+				New(unbounded, unbounded),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertDeepEqual(t, c.want, NewSet(c.intervals...).Intervals())
+		})
+	}
+}
+
+func TestIntervalSetAddRemove(t *testing.T) {
+	var s IntervalSet[Int]
+	assertEqual(t, true, s.IsEmpty())
+
+	s.Add(New(ClosedEp(Int(1)), OpenEp(Int(3))))
+	s.Add(New(ClosedEp(Int(5)), ClosedEp(Int(7))))
+	s.Add(New(ClosedEp(Int(3)), OpenEp(Int(4))))
+	assertDeepEqual(t, []Interval[Int]{
+		New(ClosedEp(Int(1)), OpenEp(Int(4))),
+		New(ClosedEp(Int(5)), ClosedEp(Int(7))),
+	}, s.Intervals())
+
+	s.Remove(New(OpenEp(Int(2)), ClosedEp(Int(6))))
+	assertDeepEqual(t, []Interval[Int]{
+		New(ClosedEp(Int(1)), ClosedEp(Int(2))),
+		New(OpenEp(Int(6)), ClosedEp(Int(7))),
+	}, s.Intervals())
+	assertEqual(t, 2, s.Len())
+
+	s.Remove(New(UnboundedEp[Int](), UnboundedEp[Int]()))
+	assertEqual(t, true, s.IsEmpty())
+}
+
+func TestIntervalSetContains(t *testing.T) {
+	s := NewSet(
+		New(UnboundedEp[Int](), OpenEp(Int(1))),
+		New(OpenEp(Int(3)), ClosedEp(Int(5))),
+		New(ClosedEp(Int(7)), ClosedEp(Int(7))),
+	)
+	cases := []struct {
+		point Int
+		want  bool
+	}{
+		{point: Int(0), want: true},
+		{point: Int(1), want: false},
+		{point: Int(3), want: false},
+		{point: Int(4), want: true},
+		{point: Int(5), want: true},
+		{point: Int(6), want: false},
+		{point: Int(7), want: true},
+		{point: Int(8), want: false},
+	}
+
+	for _, c := range cases {
+		assertEqual(t, c.want, s.Contains(c.point))
+	}
+	assertEqual(t, false, IntervalSet[Int]{}.Contains(Int(0)))
+}
+
+func TestIntervalSetOperations(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	s := NewSet(
+		New(ClosedEp(Int(10)), OpenEp(Int(30))),
+		New(ClosedEp(Int(50)), ClosedEp(Int(80))),
+	)
+	s2 := NewSet(
+		New(OpenEp(Int(20)), ClosedEp(Int(60))),
+		New(ClosedEp(Int(70)), unbounded),
+	)
+
+	t.Run("Union", func(t *testing.T) {
+		assertDeepEqual(t, []Interval[Int]{
+			New(ClosedEp(Int(10)), unbounded),
+		}, s.Union(s2).Intervals())
+	})
+	t.Run("Intersect", func(t *testing.T) {
+		assertDeepEqual(t, []Interval[Int]{
+			New(OpenEp(Int(20)), OpenEp(Int(30))),
+			New(ClosedEp(Int(50)), ClosedEp(Int(60))),
+			New(ClosedEp(Int(70)), ClosedEp(Int(80))),
+		}, s.Intersect(s2).Intervals())
+	})
+	t.Run("Difference", func(t *testing.T) {
+		assertDeepEqual(t, []Interval[Int]{
+			New(ClosedEp(Int(10)), ClosedEp(Int(20))),
+			New(OpenEp(Int(60)), OpenEp(Int(70))),
+		}, s.Difference(s2).Intervals())
+	})
+	t.Run("Complement", func(t *testing.T) {
+		assertDeepEqual(t, []Interval[Int]{
+			New(unbounded, OpenEp(Int(10))),
+			New(ClosedEp(Int(30)), OpenEp(Int(50))),
+			New(OpenEp(Int(80)), unbounded),
+		}, s.Complement().Intervals())
+		assertDeepEqual(t, []Interval[Int]{
+			New(unbounded, ClosedEp(Int(20))),
+			New(OpenEp(Int(60)), OpenEp(Int(70))),
+		}, s2.Complement().Intervals())
+	})
+	t.Run("Complement of empty set", func(t *testing.T) {
+		assertDeepEqual(t, []Interval[Int]{
+			New(unbounded, unbounded),
+		}, IntervalSet[Int]{}.Complement().Intervals())
+	})
+	t.Run("Complement of entire set", func(t *testing.T) {
+		assertEqual(t, true, NewSet(New(unbounded, unbounded)).Complement().IsEmpty())
+	})
+}
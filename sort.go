@@ -0,0 +1,80 @@
+package interval
+
+import (
+	"slices"
+	"sort"
+)
+
+// CompareLower compares lower endpoints and returns -1, 0 or 1.
+// Unbounded endpoints are -inf, and at the same value an open endpoint
+// is greater than a closed one, since it starts after the value.
+func CompareLower[T Ordered[T]](e, e2 Endpoint[T]) int {
+	return sign(comparer[T]().ep(e, false, e2, false))
+}
+
+// CompareUpper compares upper endpoints and returns -1, 0 or 1.
+// Unbounded endpoints are +inf, and at the same value an open endpoint
+// is less than a closed one, since it ends before the value.
+func CompareUpper[T Ordered[T]](e, e2 Endpoint[T]) int {
+	return sign(comparer[T]().ep(e, true, e2, true))
+}
+
+// CompareLowerUpper compares a lower endpoint with an upper endpoint and returns -1, 0 or 1.
+// It returns 0 if they touch without a gap or a shared point, like the 3 of [1, 3) and [3, 5],
+// and -1 if the interval from lower to upper is non-empty.
+func CompareLowerUpper[T Ordered[T]](lower, upper Endpoint[T]) int {
+	return sign(comparer[T]().ep(lower, false, upper, true))
+}
+
+// Compare compares intervals by lower endpoints, then by upper endpoints,
+// and returns -1, 0 or 1. Empty intervals are less than the others and equal to each other,
+// and discrete intervals are compared in their canonical forms, so Compare returns 0
+// if and only if Equal returns true.
+func (i Interval[T]) Compare(i2 Interval[T]) int {
+	d := domainOf[T]()
+	c, c2 := d.canonical(i), d.canonical(i2)
+	e, e2 := c.isEmpty(d.compare), c2.isEmpty(d.compare)
+	switch {
+	case e && e2:
+		return 0
+	case e:
+		return -1
+	case e2:
+		return 1
+	}
+	return sign(compareIntervals(c, c2, d.compare))
+}
+
+// Sort sorts intervals in ascending order of Compare.
+func Sort[T Ordered[T]](intervals []Interval[T]) {
+	SortFunc(intervals, Interval[T].Compare)
+}
+
+// SortFunc sorts intervals in ascending order as determined by cmp,
+// which returns a negative number, zero or a positive number like Compare.
+func SortFunc[T Ordered[T]](intervals []Interval[T], cmp func(i, i2 Interval[T]) int) {
+	slices.SortFunc(intervals, cmp)
+}
+
+// Search searches a sorted slice of disjoint intervals, like IntervalSet.Intervals returns,
+// for the interval containing the point with given value.
+// It returns the index of the interval and true if found,
+// otherwise the index where an interval containing the point would be inserted and false.
+func Search[T Ordered[T]](intervals []Interval[T], p T) (int, bool) {
+	// find the first interval which does not end before p
+	compare := comparer[T]()
+	k := sort.Search(len(intervals), func(k int) bool {
+		return !intervals[k].endsBefore(p, compare)
+	})
+	return k, k < len(intervals) && intervals[k].Contains(p)
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
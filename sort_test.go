@@ -0,0 +1,117 @@
+package interval
+
+import "testing"
+
+func TestCompareEndpoints(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	cases := []struct {
+		name                       string
+		e, e2                      Endpoint[Int]
+		lower, upper, lowerToUpper int
+	}{
+		{"less value", ClosedEp(Int(1)), OpenEp(Int(3)), -1, -1, -1},
+		{"greater value", OpenEp(Int(3)), ClosedEp(Int(1)), 1, 1, 1},
+		{"closed, closed", ClosedEp(Int(1)), ClosedEp(Int(1)), 0, 0, -1},
+		{"closed, open", ClosedEp(Int(1)), OpenEp(Int(1)), -1, 1, 0},
+		{"open, closed", OpenEp(Int(1)), ClosedEp(Int(1)), 1, -1, 0},
+		{"open, open", OpenEp(Int(1)), OpenEp(Int(1)), 0, 0, 1},
+		{"unbounded, bounded", unbounded, ClosedEp(Int(1)), -1, 1, -1},
+		{"bounded, unbounded", ClosedEp(Int(1)), unbounded, 1, -1, -1},
+		{"unbounded, unbounded", unbounded, unbounded, 0, 0, -1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.lower, CompareLower(c.e, c.e2))
+			assertEqual(t, c.upper, CompareUpper(c.e, c.e2))
+			assertEqual(t, c.lowerToUpper, CompareLowerUpper(c.e, c.e2))
+		})
+	}
+}
+
+func TestIntervalCompare(t *testing.T) {
+	cases := []struct {
+		name  string
+		i, i2 Interval[Int]
+		want  int
+	}{
+		{"less lower", Closed(Int(1), Int(9)), Closed(Int(3), Int(5)), -1},
+		{"same lower, less upper", Closed(Int(1), Int(5)), Closed(Int(1), Int(9)), -1},
+		{"closed and open lower", Closed(Int(1), Int(5)), OpenClosed(Int(1), Int(5)), -1},
+		{"unbounded lower", AtMost(Int(9)), Closed(Int(1), Int(5)), -1},
+		{"same", Closed(Int(1), Int(5)), Closed(Int(1), Int(5)), 0},
+		{"canonical forms", Closed(Int(1), Int(5)), ClosedOpen(Int(1), Int(6)), 0},
+		{"empty", Interval[Int]{}, AtMost(Int(9)), -1},
+		{"empty, empty", Interval[Int]{}, Open(Int(3), Int(4)), 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertEqual(t, c.want, c.i.Compare(c.i2))
+			assertEqual(t, -c.want, c.i2.Compare(c.i))
+			assertEqual(t, c.want == 0, c.i.Equal(c.i2))
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	intervals := []Interval[Int]{
+		Closed(Int(3), Int(5)),
+		AtLeast(Int(1)),
+		Closed(Int(1), Int(2)),
+		Interval[Int]{},
+		LessThan(Int(4)),
+	}
+	Sort(intervals)
+	assertDeepEqual(t, []Interval[Int]{
+		{},
+		LessThan(Int(4)),
+		Closed(Int(1), Int(2)),
+		AtLeast(Int(1)),
+		Closed(Int(3), Int(5)),
+	}, intervals)
+
+	// by upper endpoints in descending order
+	SortFunc(intervals, func(i, i2 Interval[Int]) int {
+		return CompareUpper(i2.Upper, i.Upper)
+	})
+	assertDeepEqual(t, []Interval[Int]{
+		AtLeast(Int(1)),
+		Closed(Int(3), Int(5)),
+		LessThan(Int(4)),
+		Closed(Int(1), Int(2)),
+		{},
+	}, intervals)
+}
+
+func TestSearch(t *testing.T) {
+	intervals := []Interval[Int]{
+		LessThan(Int(0)),
+		Closed(Int(2), Int(4)),
+		OpenClosed(Int(6), Int(8)),
+	}
+	cases := []struct {
+		p    Int
+		want int
+		ok   bool
+	}{
+		{-5, 0, true},
+		{0, 1, false},
+		{2, 1, true},
+		{4, 1, true},
+		{5, 2, false},
+		{6, 2, false},
+		{8, 2, true},
+		{9, 3, false},
+	}
+
+	for _, c := range cases {
+		k, ok := Search(intervals, c.p)
+		assertEqual(t, c.want, k)
+		assertEqual(t, c.ok, ok)
+	}
+
+	k, ok := Search[Int](nil, 1)
+	assertEqual(t, 0, k)
+	assertEqual(t, false, ok)
+}
@@ -0,0 +1,276 @@
+package interval
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Scan implements sql.Scanner for PostgreSQL range types such as int4range and tstzrange.
+// It reads the text format of ranges like [1,3), (,5] or empty,
+// and scans each bound with Scan of *T. *T must implement sql.Scanner
+// unless T is a numeric, string or []byte kind.
+// Bounds written as infinity or -infinity are regarded as unbounded.
+func (i *Interval[T]) Scan(src any) error {
+	s, err := srcText(src)
+	if err != nil {
+		return err
+	}
+	p := pgParser{s: strings.TrimSpace(s)}
+	res, err := scanRange[T](&p)
+	if err != nil {
+		return err
+	}
+	if !p.eof() {
+		return fmt.Errorf("%w: %q", ErrSyntax, s)
+	}
+	*i = res
+	return nil
+}
+
+// Value implements driver.Valuer for PostgreSQL range types.
+// Interval is written in the text format of ranges like [1,3) or (,5].
+// Bounds are written with Value of T if T implements driver.Valuer.
+func (i Interval[T]) Value() (driver.Value, error) {
+	var b strings.Builder
+	if err := i.writeRange(&b); err != nil {
+		return nil, err
+	}
+	return b.String(), nil
+}
+
+// Scan implements sql.Scanner for PostgreSQL multirange types such as int4multirange.
+// It reads the text format like {[1,3),[5,7)}. See Interval.Scan for the format of each range.
+func (s *IntervalSet[T]) Scan(src any) error {
+	text, err := srcText(src)
+	if err != nil {
+		return err
+	}
+	p := pgParser{s: strings.TrimSpace(text)}
+	if !p.consume('{') {
+		return fmt.Errorf("%w: %q", ErrSyntax, text)
+	}
+	var intervals []Interval[T]
+	for !p.consume('}') {
+		if len(intervals) > 0 && !p.consume(',') {
+			return fmt.Errorf("%w: %q", ErrSyntax, text)
+		}
+		i, err := scanRange[T](&p)
+		if err != nil {
+			return err
+		}
+		intervals = append(intervals, i)
+	}
+	if !p.eof() {
+		return fmt.Errorf("%w: %q", ErrSyntax, text)
+	}
+	*s = NewSet(intervals...)
+	return nil
+}
+
+// Value implements driver.Valuer for PostgreSQL multirange types.
+// Set is written in the text format like {[1,3),[5,7)}.
+func (s IntervalSet[T]) Value() (driver.Value, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for k, i := range s.intervals {
+		if k > 0 {
+			b.WriteByte(',')
+		}
+		if err := i.writeRange(&b); err != nil {
+			return nil, err
+		}
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+func srcText(src any) (string, error) {
+	switch src := src.(type) {
+	case string:
+		return src, nil
+	case []byte:
+		return string(src), nil
+	default:
+		return "", fmt.Errorf("interval: cannot scan %T", src)
+	}
+}
+
+// writeRange writes interval in the text format of PostgreSQL ranges.
+func (i Interval[T]) writeRange(b *strings.Builder) error {
+	if i.IsEmpty() {
+		b.WriteString("empty")
+		return nil
+	}
+	if i.Lower.Closed && i.Lower.Bounded() {
+		b.WriteByte('[')
+	} else {
+		b.WriteByte('(')
+	}
+	if i.Lower.Bounded() {
+		if err := writeBound(b, i.Lower.Value); err != nil {
+			return err
+		}
+	}
+	b.WriteByte(',')
+	if i.Upper.Bounded() {
+		if err := writeBound(b, i.Upper.Value); err != nil {
+			return err
+		}
+	}
+	if i.Upper.Closed && i.Upper.Bounded() {
+		b.WriteByte(']')
+	} else {
+		b.WriteByte(')')
+	}
+	return nil
+}
+
+// writeBound writes v as a bound of a range, quoting it if needed.
+func writeBound(b *strings.Builder, v any) error {
+	if valuer, ok := v.(driver.Valuer); ok {
+		dv, err := valuer.Value()
+		if err != nil {
+			return err
+		}
+		v = dv
+	}
+	var s string
+	switch v := v.(type) {
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case float64:
+		s = strconv.FormatFloat(v, 'g', -1, 64)
+	case bool:
+		s = strconv.FormatBool(v)
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case time.Time:
+		s = v.Format("2006-01-02 15:04:05.999999999Z07:00")
+	default:
+		s = fmt.Sprint(v)
+	}
+	if s != "" && !strings.ContainsAny(s, `,()[]{}"\ `) {
+		b.WriteString(s)
+		return nil
+	}
+	b.WriteByte('"')
+	for _, r := range s {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+	return nil
+}
+
+// scanRange reads a range at the position of p.
+func scanRange[T Ordered[T]](p *pgParser) (Interval[T], error) {
+	if p.consumeWord("empty") {
+		return Interval[T]{}, nil
+	}
+	lowerClosed := p.consume('[')
+	if !lowerClosed && !p.consume('(') {
+		return Interval[T]{}, fmt.Errorf("%w: %q", ErrSyntax, p.s)
+	}
+	lower, lowerOK := p.bound()
+	if !p.consume(',') {
+		return Interval[T]{}, fmt.Errorf("%w: %q", ErrSyntax, p.s)
+	}
+	upper, upperOK := p.bound()
+	upperClosed := p.consume(']')
+	if !upperClosed && !p.consume(')') {
+		return Interval[T]{}, fmt.Errorf("%w: %q", ErrSyntax, p.s)
+	}
+
+	l, err := scanBound[T](lower, lowerOK, lowerClosed)
+	if err != nil {
+		return Interval[T]{}, err
+	}
+	u, err := scanBound[T](upper, upperOK, upperClosed)
+	if err != nil {
+		return Interval[T]{}, err
+	}
+	return New(l, u), nil
+}
+
+// scanBound converts the text of a bound to an endpoint.
+func scanBound[T Ordered[T]](s string, ok, closed bool) (Endpoint[T], error) {
+	if !ok || s == "infinity" || s == "-infinity" {
+		return UnboundedEp[T](), nil
+	}
+	var v T
+	var err error
+	if scanner, ok := any(&v).(sql.Scanner); ok {
+		err = scanner.Scan(s)
+	} else {
+		err = parseBasic(s, &v)
+	}
+	if err != nil {
+		return Endpoint[T]{}, err
+	}
+	return Endpoint[T]{Value: v, Closed: closed}, nil
+}
+
+// pgParser reads the text format of PostgreSQL ranges.
+type pgParser struct {
+	s   string
+	pos int
+}
+
+func (p *pgParser) eof() bool {
+	return p.pos >= len(p.s)
+}
+
+func (p *pgParser) consume(c byte) bool {
+	if p.pos < len(p.s) && p.s[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *pgParser) consumeWord(w string) bool {
+	if len(p.s)-p.pos >= len(w) && strings.EqualFold(p.s[p.pos:p.pos+len(w)], w) {
+		p.pos += len(w)
+		return true
+	}
+	return false
+}
+
+// bound reads a bound value, which may be quoted.
+// It returns false if the bound is omitted, meaning unbounded.
+func (p *pgParser) bound() (string, bool) {
+	var b strings.Builder
+	quoted, ok := false, false
+	for ; p.pos < len(p.s); p.pos++ {
+		c := p.s[p.pos]
+		switch {
+		case c == '"':
+			// "" inside quotes is a literal quote
+			if quoted && p.pos+1 < len(p.s) && p.s[p.pos+1] == '"' {
+				b.WriteByte('"')
+				p.pos++
+			} else {
+				quoted = !quoted
+			}
+			ok = true
+		case c == '\\' && p.pos+1 < len(p.s):
+			p.pos++
+			b.WriteByte(p.s[p.pos])
+			ok = true
+		case !quoted && strings.IndexByte(",)]", c) >= 0:
+			return b.String(), ok
+		default:
+			b.WriteByte(c)
+			ok = true
+		}
+	}
+	return b.String(), ok
+}
@@ -0,0 +1,211 @@
+package interval
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIntervalScan(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	cases := []struct {
+		src  any
+		want Interval[Int]
+	}{
+		{src: "[1,3)", want: New(ClosedEp(Int(1)), OpenEp(Int(3)))},
+		{src: []byte("(1,3]"), want: New(OpenEp(Int(1)), ClosedEp(Int(3)))},
+		{src: "(,5)", want: New(unbounded, OpenEp(Int(5)))},
+		{src: "[-2,)", want: New(ClosedEp(Int(-2)), unbounded)},
+		{src: "(,)", want: New(unbounded, unbounded)},
+		{src: `["1","3")`, want: New(ClosedEp(Int(1)), OpenEp(Int(3)))},
+		{src: "empty", want: Interval[Int]{}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.want.String(), func(t *testing.T) {
+			var got Interval[Int]
+			if err := got.Scan(c.src); err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, c.want, got)
+		})
+	}
+
+	t.Run("tstzrange", func(t *testing.T) {
+		var got Interval[Time]
+		if err := got.Scan(`["2020-01-01 00:00:00+00","2020-01-02 09:30:00.5+05:30")`); err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, true, got.Equal(New(
+			ClosedEp(Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))),
+			OpenEp(Time(time.Date(2020, 1, 2, 4, 0, 0, 5e8, time.UTC))),
+		)))
+	})
+
+	t.Run("daterange", func(t *testing.T) {
+		var got Interval[Time]
+		if err := got.Scan("[2020-01-01,infinity)"); err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, true, got.Equal(New(
+			ClosedEp(Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))),
+			UnboundedEp[Time](),
+		)))
+	})
+}
+
+func TestIntervalScanError(t *testing.T) {
+	cases := []any{
+		nil,
+		1,
+		"",
+		"1,3",
+		"[1 3)",
+		"[1,3",
+		"[1,3)x",
+		"[a,3)",
+	}
+
+	for _, src := range cases {
+		var got Interval[Int]
+		if err := got.Scan(src); err == nil {
+			t.Errorf("want error for %v, got nil", src)
+		}
+	}
+
+	var got Interval[pair]
+	if err := got.Scan("[1,3)"); err == nil {
+		t.Error("want error for a type without Scan, got nil")
+	}
+
+	var got2 Interval[plain]
+	if err := got2.Scan("[1,3)"); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, New(ClosedEp(plain(1)), OpenEp(plain(3))), got2)
+}
+
+func TestIntervalValue(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	cases := []struct {
+		interval any
+		want     string
+	}{
+		{interval: New(ClosedEp(Int(1)), OpenEp(Int(3))), want: "[1,3)"},
+		{interval: New(unbounded, ClosedEp(Int(5))), want: "(,5]"},
+		{interval: New(unbounded, unbounded), want: "(,)"},
+		{interval: Interval[Int]{}, want: "empty"},
+		{
+			interval: New(
+				ClosedEp(Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))),
+				OpenEp(Time(time.Date(2020, 1, 2, 3, 4, 5, 6, time.FixedZone("", 9*60*60)))),
+			),
+			want: `["2020-01-01 00:00:00Z","2020-01-02 03:04:05.000000006+09:00")`,
+		},
+		{interval: New(ClosedEp(plain(1)), ClosedEp(plain(2))), want: "[1,2]"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.want, func(t *testing.T) {
+			var (
+				got any
+				err error
+			)
+			switch i := c.interval.(type) {
+			case Interval[Int]:
+				got, err = i.Value()
+			case Interval[Time]:
+				got, err = i.Value()
+			case Interval[plain]:
+				got, err = i.Value()
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertEqual(t, c.want, got)
+		})
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		i := New(
+			OpenEp(Time(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))),
+			ClosedEp(Time(time.Date(2020, 1, 2, 3, 4, 5, 6, time.FixedZone("", -3*60*60)))),
+		)
+		v, err := i.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Interval[Time]
+		if err := got.Scan(v); err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, true, i.Equal(got))
+	})
+}
+
+func TestIntervalSetSQL(t *testing.T) {
+	var s IntervalSet[Int]
+	if err := s.Scan("{[1,3),[5,7),(,0)}"); err != nil {
+		t.Fatal(err)
+	}
+	assertDeepEqual(t, []Interval[Int]{
+		New(UnboundedEp[Int](), OpenEp(Int(0))),
+		New(ClosedEp(Int(1)), OpenEp(Int(3))),
+		New(ClosedEp(Int(5)), OpenEp(Int(7))),
+	}, s.Intervals())
+
+	v, err := s.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "{(,0),[1,3),[5,7)}", v)
+
+	if err := s.Scan("{}"); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, true, s.IsEmpty())
+	v, err = s.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, "{}", v)
+
+	for _, src := range []any{nil, "", "[1,3)", "{[1,3)", "{[1,3)[5,7)}", "{[1,3)}x"} {
+		if err := s.Scan(src); err == nil {
+			t.Errorf("want error for %v, got nil", src)
+		}
+	}
+}
+
+func TestIntSQL(t *testing.T) {
+	var i Int
+	for _, src := range []any{int64(3), "3", []byte("3")} {
+		if err := i.Scan(src); err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, Int(3), i)
+	}
+	if err := i.Scan(3.0); err == nil {
+		t.Error("want error, got nil")
+	}
+	v, _ := Int(3).Value()
+	assertEqual(t, int64(3), v)
+}
+
+func TestTimeSQL(t *testing.T) {
+	want := Time(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	for _, src := range []any{time.Time(want), "2020-01-02T03:04:05Z", "2020-01-02 03:04:05+00", []byte("2020-01-02 03:04:05")} {
+		var tm Time
+		if err := tm.Scan(src); err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, true, tm.Equal(want))
+	}
+	var tm Time
+	for _, src := range []any{1, "2020/01/02"} {
+		if err := tm.Scan(src); err == nil {
+			t.Errorf("want error for %v, got nil", src)
+		}
+	}
+	v, _ := want.Value()
+	assertEqual(t, time.Time(want), v)
+}
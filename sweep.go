@@ -0,0 +1,95 @@
+package interval
+
+import "sort"
+
+// DepthSegment is a piece of a depth profile,
+// an interval where the same number of intervals overlap.
+type DepthSegment[T Ordered[T]] struct {
+	Interval Interval[T]
+	Depth    int
+}
+
+// event is where the depth changes by delta while sweeping endpoints.
+type event[T Ordered[T]] struct {
+	ep    Endpoint[T]
+	upper bool
+	delta int
+}
+
+// lowerEp returns the lower endpoint of a segment starting at the event.
+func (e event[T]) lowerEp() Endpoint[T] {
+	if e.upper {
+		return e.ep.flip()
+	}
+	return e.ep
+}
+
+// upperEp returns the upper endpoint of a segment ending at the event.
+func (e event[T]) upperEp() Endpoint[T] {
+	if e.upper {
+		return e.ep
+	}
+	return e.ep.flip()
+}
+
+// DepthProfile returns the number of intervals overlapping at each point as a step function,
+// that is, the maximal segments where the depth is positive and constant, in ascending order.
+// Intervals sharing no point like [1, 3) and [3, 5] never overlap, as with Interval.Overlaps.
+// Empty intervals are ignored, and discrete intervals are swept in their canonical forms.
+func DepthProfile[T Ordered[T]](intervals []Interval[T]) []DepthSegment[T] {
+	d := domainOf[T]()
+	var events []event[T]
+	for _, i := range intervals {
+		if i = d.canonical(i); i.isEmpty(d.compare) {
+			continue
+		}
+		events = append(events,
+			event[T]{ep: i.Lower, upper: false, delta: 1},
+			event[T]{ep: i.Upper, upper: true, delta: -1},
+		)
+	}
+	compare := d.compare
+	sort.Slice(events, func(a, b int) bool {
+		return compare.ep(events[a].ep, events[a].upper, events[b].ep, events[b].upper) < 0
+	})
+
+	var res []DepthSegment[T]
+	depth := 0
+	for k := 0; k < len(events); {
+		// apply all the events at the same position
+		at, prev := events[k], depth
+		for ; k < len(events) && compare.ep(events[k].ep, events[k].upper, at.ep, at.upper) == 0; k++ {
+			depth += events[k].delta
+		}
+		if depth == prev {
+			continue
+		}
+		if prev > 0 {
+			res[len(res)-1].Interval.Upper = at.upperEp()
+		}
+		if depth > 0 {
+			res = append(res, DepthSegment[T]{
+				Interval: Interval[T]{Lower: at.lowerEp()},
+				Depth:    depth,
+			})
+		}
+	}
+	return res
+}
+
+// MaxDepth returns the greatest number of intervals overlapping at a point,
+// and the maximal intervals where the number is reached in ascending order.
+// It returns 0 and nil if all intervals are empty.
+func MaxDepth[T Ordered[T]](intervals []Interval[T]) (int, []Interval[T]) {
+	max := 0
+	var res []Interval[T]
+	for _, s := range DepthProfile(intervals) {
+		switch {
+		case s.Depth > max:
+			max, res = s.Depth, []Interval[T]{s.Interval}
+		case s.Depth == max:
+			res = append(res, s.Interval)
+		}
+	}
+	return max, res
+}
@@ -0,0 +1,120 @@
+package interval
+
+import "testing"
+
+func testDepthProfile[T Ordered[T]](t *testing.T, v1, v2, v3, v4 T) {
+	cases := []struct {
+		name      string
+		intervals []Interval[T]
+		want      []DepthSegment[T]
+	}{
+		{"none", nil, nil},
+		{
+			name:      "single",
+			intervals: []Interval[T]{ClosedOpen(v1, v2)},
+			want:      []DepthSegment[T]{{ClosedOpen(v1, v2), 1}},
+		},
+		{
+			name:      "overlapping",
+			intervals: []Interval[T]{ClosedOpen(v1, v3), ClosedOpen(v2, v4)},
+			want: []DepthSegment[T]{
+				{ClosedOpen(v1, v2), 1},
+				{ClosedOpen(v2, v3), 2},
+				{ClosedOpen(v3, v4), 1},
+			},
+		},
+		{
+			name:      "touching",
+			intervals: []Interval[T]{ClosedOpen(v2, v3), ClosedOpen(v1, v2)},
+			want:      []DepthSegment[T]{{ClosedOpen(v1, v3), 1}},
+		},
+		{
+			name:      "apart",
+			intervals: []Interval[T]{ClosedOpen(v1, v2), ClosedOpen(v3, v4)},
+			want:      []DepthSegment[T]{{ClosedOpen(v1, v2), 1}, {ClosedOpen(v3, v4), 1}},
+		},
+		{
+			name:      "same",
+			intervals: []Interval[T]{ClosedOpen(v1, v2), ClosedOpen(v1, v2), Interval[T]{}},
+			want:      []DepthSegment[T]{{ClosedOpen(v1, v2), 2}},
+		},
+		{
+			name:      "unbounded",
+			intervals: []Interval[T]{LessThan(v2), AtLeast(v1), Entire[T]()},
+			want: []DepthSegment[T]{
+				{LessThan(v1), 2},
+				{ClosedOpen(v1, v2), 3},
+				{AtLeast(v2), 2},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertDeepEqual(t, c.want, DepthProfile(c.intervals))
+		})
+	}
+}
+
+func testMaxDepth[T Ordered[T]](t *testing.T, v1, v2, v3, v4 T) {
+	depth, at := MaxDepth([]Interval[T]{
+		ClosedOpen(v1, v4),
+		ClosedOpen(v1, v2),
+		ClosedOpen(v3, v4),
+		ClosedOpen(v2, v3),
+	})
+	assertEqual(t, 2, depth)
+	assertDeepEqual(t, []Interval[T]{ClosedOpen(v1, v4)}, at)
+
+	depth, at = MaxDepth([]Interval[T]{
+		ClosedOpen(v1, v3),
+		ClosedOpen(v2, v4),
+		ClosedOpen(v1, v2),
+		ClosedOpen(v3, v4),
+	})
+	assertEqual(t, 2, depth)
+	assertDeepEqual(t, []Interval[T]{ClosedOpen(v1, v4)}, at)
+
+	depth, at = MaxDepth([]Interval[T]{Interval[T]{}})
+	assertEqual(t, 0, depth)
+	assertDeepEqual(t, []Interval[T](nil), at)
+}
+
+func TestDepthProfileClosedness(t *testing.T) {
+	f := func(v float64) Float64 { return Float64(v) }
+	cases := []struct {
+		name      string
+		intervals []Interval[Float64]
+		want      []DepthSegment[Float64]
+	}{
+		{
+			name:      "closed-open and closed",
+			intervals: []Interval[Float64]{ClosedOpen(f(1), f(3)), Closed(f(3), f(5))},
+			want:      []DepthSegment[Float64]{{Closed(f(1), f(5)), 1}},
+		},
+		{
+			name:      "sharing a point",
+			intervals: []Interval[Float64]{Closed(f(1), f(3)), Closed(f(3), f(5))},
+			want: []DepthSegment[Float64]{
+				{ClosedOpen(f(1), f(3)), 1},
+				{Closed(f(3), f(3)), 2},
+				{OpenClosed(f(3), f(5)), 1},
+			},
+		},
+		{
+			name:      "missing a point",
+			intervals: []Interval[Float64]{Open(f(1), f(3)), Open(f(3), f(5))},
+			want:      []DepthSegment[Float64]{{Open(f(1), f(3)), 1}, {Open(f(3), f(5)), 1}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assertDeepEqual(t, c.want, DepthProfile(c.intervals))
+		})
+	}
+
+	depth, at := MaxDepth([]Interval[Float64]{Closed(f(1), f(3)), Closed(f(3), f(5)), ClosedOpen(f(0), f(1))})
+	assertEqual(t, 2, depth)
+	assertDeepEqual(t, []Interval[Float64]{Closed(f(3), f(3))}, at)
+}
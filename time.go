@@ -1,6 +1,10 @@
 package interval
 
-import "time"
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
 
 var _ Ordered[Time] = Time{}
 
@@ -17,3 +21,75 @@ func (t Time) Equal(t2 Time) bool {
 func (t Time) LessThan(t2 Time) bool {
 	return time.Time(t).Before(time.Time(t2))
 }
+
+// compareTime compares t and t2 as time.Time.Compare does.
+func compareTime(t, t2 Time) int {
+	return time.Time(t).Compare(time.Time(t2))
+}
+
+// String returns t in RFC 3339 format with fractional seconds.
+func (t Time) String() string {
+	return time.Time(t).Format(time.RFC3339Nano)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// Time is encoded in RFC 3339 format with fractional seconds.
+func (t Time) MarshalText() ([]byte, error) {
+	return time.Time(t).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Time is decoded from RFC 3339 format.
+func (t *Time) UnmarshalText(text []byte) error {
+	return (*time.Time)(t).UnmarshalText(text)
+}
+
+// MarshalJSON implements json.Marshaler.
+// Time is encoded as a JSON string in RFC 3339 format with fractional seconds.
+func (t Time) MarshalJSON() ([]byte, error) {
+	return time.Time(t).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	return (*time.Time)(t).UnmarshalJSON(data)
+}
+
+// layouts of times accepted by Scan.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// Scan implements sql.Scanner.
+// It accepts time.Time and the text representations of PostgreSQL timestamps and dates.
+// Times without time zone are regarded as UTC.
+func (t *Time) Scan(src any) error {
+	var s string
+	switch src := src.(type) {
+	case time.Time:
+		*t = Time(src)
+		return nil
+	case string:
+		s = src
+	case []byte:
+		s = string(src)
+	default:
+		return fmt.Errorf("interval: cannot scan %T into Time", src)
+	}
+	for _, layout := range timeLayouts {
+		if tm, err := time.Parse(layout, s); err == nil {
+			*t = Time(tm)
+			return nil
+		}
+	}
+	return fmt.Errorf("interval: cannot parse %q as Time", s)
+}
+
+// Value implements driver.Valuer.
+func (t Time) Value() (driver.Value, error) {
+	return time.Time(t), nil
+}
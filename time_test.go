@@ -19,6 +19,12 @@ func TestTime(t *testing.T) {
 	t.Run("NewInterval", func(t *testing.T) {
 		testNewInterval(t, t1, t2)
 	})
+	t.Run("Constructors", func(t *testing.T) {
+		testConstructors(t, t1, t2)
+	})
+	t.Run("Validate", func(t *testing.T) {
+		testValidate(t, t1, t2)
+	})
 	t.Run("IsEmpty", func(t *testing.T) {
 		testIsEmpty(t, t1, t2)
 	})
@@ -31,4 +37,36 @@ func TestTime(t *testing.T) {
 	t.Run("CompareInterval", func(t *testing.T) {
 		testCompareInterval(t, t1, t2, t3, t4)
 	})
+	t.Run("Intersect", func(t *testing.T) {
+		testIntersect(t, t1, t2, t3, t4)
+	})
+	t.Run("Hull", func(t *testing.T) {
+		testHull(t, t1, t2, t3, t4)
+	})
+	t.Run("Difference", func(t *testing.T) {
+		testDifference(t, t1, t2, t3, t4)
+	})
+	t.Run("Relation", func(t *testing.T) {
+		testRelation(t, t1, t2, t3, t4)
+	})
+	t.Run("Predicates", func(t *testing.T) {
+		testPredicates(t, t1, t2, t3, t4)
+	})
+	t.Run("Gap", func(t *testing.T) {
+		testGap(t, t1, t2, t3, t4)
+	})
+	t.Run("Span", func(t *testing.T) {
+		testSpan(t, t1, t2, t3, t4)
+	})
+	t.Run("DepthProfile", func(t *testing.T) {
+		testDepthProfile(t, t1, t2, t3, t4)
+	})
+	t.Run("MaxDepth", func(t *testing.T) {
+		testMaxDepth(t, t1, t2, t3, t4)
+	})
+}
+
+func TestTimeString(t *testing.T) {
+	tm := Time(time.Date(2020, 1, 2, 3, 4, 5, 600, time.FixedZone("", 9*60*60)))
+	assertEqual(t, "2020-01-02T03:04:05.0000006+09:00", tm.String())
 }
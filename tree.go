@@ -0,0 +1,225 @@
+package interval
+
+// Tree is an interval tree mapping intervals to values.
+// It is a self-balancing binary search tree ordered by lower endpoints,
+// where each node also holds the greatest upper endpoint in its subtree.
+// Multiple entries may have the same interval.
+// Intervals are ordered and matched by the values they contain,
+// so [1, 3] and [1, 4) are the same interval of Int.
+// The zero value of Tree is an empty tree.
+type Tree[T Ordered[T], V any] struct {
+	root *node[T, V]
+	size int
+}
+
+// Entry is a pair of an interval and a value stored in a Tree.
+type Entry[T Ordered[T], V any] struct {
+	Interval Interval[T]
+	Value    V
+}
+
+type node[T Ordered[T], V any] struct {
+	entry       Entry[T, V]
+	maxUpper    Endpoint[T]
+	height      int
+	left, right *node[T, V]
+}
+
+// Len returns the number of entries in tree.
+func (t *Tree[T, V]) Len() int {
+	return t.size
+}
+
+// Insert adds an entry of interval and value to tree.
+func (t *Tree[T, V]) Insert(i Interval[T], v V) {
+	d := domainOf[T]()
+	t.root = t.root.insert(Entry[T, V]{Interval: i, Value: v}, d.canonical(i), d)
+	t.size++
+}
+
+// Delete removes an entry with given interval from tree and returns its value.
+// Entries match if their intervals contain the same values as interval.
+// If several entries have the interval, only one of them is removed.
+// It returns false if no entry has the interval.
+func (t *Tree[T, V]) Delete(i Interval[T]) (V, bool) {
+	var (
+		v  V
+		ok bool
+	)
+	d := domainOf[T]()
+	t.root = t.root.delete(d.canonical(i), d, &v, &ok)
+	if ok {
+		t.size--
+	}
+	return v, ok
+}
+
+// Stab returns the entries whose intervals contain the point with given value,
+// ordered by their intervals. No entries contain NaN, as with Interval.Contains.
+func (t *Tree[T, V]) Stab(p T) []Entry[T, V] {
+	if isNaN(p) {
+		return nil
+	}
+	return t.Overlapping(New(ClosedEp(p), ClosedEp(p)))
+}
+
+// Overlapping returns the entries whose intervals overlap given interval,
+// ordered by their intervals.
+func (t *Tree[T, V]) Overlapping(i Interval[T]) []Entry[T, V] {
+	d := domainOf[T]()
+	if i = d.canonical(i); i.isEmpty(d.compare) {
+		return nil
+	}
+	var res []Entry[T, V]
+	t.root.overlapping(i, d, &res)
+	return res
+}
+
+// Ascend calls fn for each entry ordered by their intervals
+// until fn returns false.
+func (t *Tree[T, V]) Ascend(fn func(i Interval[T], v V) bool) {
+	t.root.ascend(fn)
+}
+
+// compareIntervals orders intervals by lower endpoints, then by upper endpoints.
+func compareIntervals[T Ordered[T]](i, i2 Interval[T], compare comparator[T]) int {
+	if c := compare.ep(i.Lower, false, i2.Lower, false); c != 0 {
+		return c
+	}
+	return compare.ep(i.Upper, true, i2.Upper, true)
+}
+
+// overlapping appends the entries overlapping canonical interval i to res.
+func (n *node[T, V]) overlapping(i Interval[T], d domain[T], res *[]Entry[T, V]) {
+	// no interval in this subtree reaches i
+	if n == nil || d.compare.ep(n.maxUpper, true, i.Lower, false) <= 0 {
+		return
+	}
+	n.left.overlapping(i, d, res)
+	// this node and the right subtree start after i ends
+	ni := d.canonical(n.entry.Interval)
+	if d.compare.ep(ni.Lower, false, i.Upper, true) >= 0 {
+		return
+	}
+	if ni.overlaps(i, d.compare) {
+		*res = append(*res, n.entry)
+	}
+	n.right.overlapping(i, d, res)
+}
+
+func (n *node[T, V]) ascend(fn func(i Interval[T], v V) bool) bool {
+	if n == nil {
+		return true
+	}
+	return n.left.ascend(fn) &&
+		fn(n.entry.Interval, n.entry.Value) &&
+		n.right.ascend(fn)
+}
+
+// insert adds e, whose interval is canonically key, to the subtree of n.
+func (n *node[T, V]) insert(e Entry[T, V], key Interval[T], d domain[T]) *node[T, V] {
+	if n == nil {
+		nd := &node[T, V]{entry: e}
+		nd.update(d.compare)
+		return nd
+	}
+	if compareIntervals(key, d.canonical(n.entry.Interval), d.compare) < 0 {
+		n.left = n.left.insert(e, key, d)
+	} else {
+		n.right = n.right.insert(e, key, d)
+	}
+	return n.balance(d.compare)
+}
+
+// delete removes a node whose interval is canonically i from the subtree of n.
+func (n *node[T, V]) delete(i Interval[T], d domain[T], v *V, ok *bool) *node[T, V] {
+	if n == nil {
+		return nil
+	}
+	compare := d.compare
+	switch c := compareIntervals(i, d.canonical(n.entry.Interval), compare); {
+	case c < 0:
+		n.left = n.left.delete(i, d, v, ok)
+	case c > 0:
+		n.right = n.right.delete(i, d, v, ok)
+	default:
+		*v, *ok = n.entry.Value, true
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		var succ *node[T, V]
+		n.right = n.right.deleteMin(compare, &succ)
+		succ.left, succ.right = n.left, n.right
+		n = succ
+	}
+	return n.balance(compare)
+}
+
+// deleteMin removes the leftmost node of n and stores it to m.
+func (n *node[T, V]) deleteMin(compare comparator[T], m **node[T, V]) *node[T, V] {
+	if n.left == nil {
+		*m = n
+		return n.right
+	}
+	n.left = n.left.deleteMin(compare, m)
+	return n.balance(compare)
+}
+
+// update recomputes height and maxUpper from children.
+func (n *node[T, V]) update(compare comparator[T]) {
+	n.height = 1 + n.left.getHeight()
+	if h := 1 + n.right.getHeight(); h > n.height {
+		n.height = h
+	}
+	n.maxUpper = n.entry.Interval.Upper
+	if n.left != nil && compare.ep(n.left.maxUpper, true, n.maxUpper, true) > 0 {
+		n.maxUpper = n.left.maxUpper
+	}
+	if n.right != nil && compare.ep(n.right.maxUpper, true, n.maxUpper, true) > 0 {
+		n.maxUpper = n.right.maxUpper
+	}
+}
+
+func (n *node[T, V]) getHeight() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// balance restores the AVL property of n and returns the new subtree root.
+func (n *node[T, V]) balance(compare comparator[T]) *node[T, V] {
+	n.update(compare)
+	switch bf := n.left.getHeight() - n.right.getHeight(); {
+	case bf > 1:
+		if n.left.left.getHeight() < n.left.right.getHeight() {
+			n.left = n.left.rotateLeft(compare)
+		}
+		return n.rotateRight(compare)
+	case bf < -1:
+		if n.right.right.getHeight() < n.right.left.getHeight() {
+			n.right = n.right.rotateRight(compare)
+		}
+		return n.rotateLeft(compare)
+	}
+	return n
+}
+
+func (n *node[T, V]) rotateLeft(compare comparator[T]) *node[T, V] {
+	r := n.right
+	n.right, r.left = r.left, n
+	n.update(compare)
+	r.update(compare)
+	return r
+}
+
+func (n *node[T, V]) rotateRight(compare comparator[T]) *node[T, V] {
+	l := n.left
+	n.left, l.right = l.right, n
+	n.update(compare)
+	l.update(compare)
+	return l
+}
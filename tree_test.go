@@ -0,0 +1,116 @@
+package interval
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestTree(t *testing.T) {
+	unbounded := UnboundedEp[Int]()
+	var tr Tree[Int, string]
+	tr.Insert(New(ClosedEp(Int(1)), OpenEp(Int(3))), "a")
+	tr.Insert(New(ClosedEp(Int(3)), ClosedEp(Int(5))), "b")
+	tr.Insert(New(OpenEp(Int(5)), unbounded), "c")
+	tr.Insert(New(unbounded, OpenEp(Int(1))), "d")
+	tr.Insert(New(ClosedEp(Int(1)), OpenEp(Int(3))), "e")
+	assertEqual(t, 5, tr.Len())
+
+	values := func(entries []Entry[Int, string]) []string {
+		var res []string
+		for _, e := range entries {
+			res = append(res, e.Value)
+		}
+		return res
+	}
+
+	t.Run("Stab", func(t *testing.T) {
+		assertDeepEqual(t, []string{"d"}, values(tr.Stab(Int(0))))
+		assertDeepEqual(t, []string{"a", "e"}, values(tr.Stab(Int(1))))
+		assertDeepEqual(t, []string{"b"}, values(tr.Stab(Int(3))))
+		assertDeepEqual(t, []string{"b"}, values(tr.Stab(Int(5))))
+		assertDeepEqual(t, []string{"c"}, values(tr.Stab(Int(6))))
+	})
+	t.Run("Overlapping", func(t *testing.T) {
+		assertDeepEqual(t, []string{"a", "e", "b"}, values(tr.Overlapping(New(OpenEp(Int(1)), ClosedEp(Int(3))))))
+		assertDeepEqual(t, []string{"d", "a", "e"}, values(tr.Overlapping(New(unbounded, OpenEp(Int(3))))))
+		assertDeepEqual(t, []string(nil), values(tr.Overlapping(New(OpenEp(Int(3)), OpenEp(Int(3))))))
+	})
+	t.Run("Ascend", func(t *testing.T) {
+		var got []string
+		tr.Ascend(func(i Interval[Int], v string) bool {
+			got = append(got, v)
+			return len(got) < 3
+		})
+		assertDeepEqual(t, []string{"d", "a", "e"}, got)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		v, ok := tr.Delete(New(ClosedEp(Int(3)), ClosedEp(Int(5))))
+		assertEqual(t, "b", v)
+		assertEqual(t, true, ok)
+		_, ok = tr.Delete(New(ClosedEp(Int(3)), ClosedEp(Int(5))))
+		assertEqual(t, false, ok)
+		assertEqual(t, 4, tr.Len())
+		assertDeepEqual(t, []string(nil), values(tr.Stab(Int(4))))
+
+		v, ok = tr.Delete(New(ClosedEp(Int(1)), ClosedEp(Int(2))))
+		assertEqual(t, "a", v)
+		assertEqual(t, true, ok)
+		_, ok = tr.Delete(New(OpenEp(Int(0)), OpenEp(Int(4))))
+		assertEqual(t, false, ok)
+		assertEqual(t, 3, tr.Len())
+	})
+}
+
+func TestTreeRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randInterval := func() Interval[Int] {
+		lower := Endpoint[Int]{Value: Int(r.Intn(100)), Closed: r.Intn(2) == 0, Unbounded: r.Intn(20) == 0}
+		upper := Endpoint[Int]{Value: lower.Value + Int(r.Intn(20)), Closed: r.Intn(2) == 0, Unbounded: r.Intn(20) == 0}
+		if lower.Unbounded {
+			lower.Value, lower.Closed = 0, false
+		}
+		if upper.Unbounded {
+			upper.Value, upper.Closed = 0, false
+		}
+		return New(lower, upper)
+	}
+
+	var tr Tree[Int, int]
+	var entries []Entry[Int, int]
+	for k := 0; k < 2000; k++ {
+		if len(entries) > 0 && r.Intn(3) == 0 {
+			n := r.Intn(len(entries))
+			if _, ok := tr.Delete(entries[n].Interval); !ok {
+				t.Fatalf("failed to delete %v", entries[n].Interval)
+			}
+			entries = append(entries[:n], entries[n+1:]...)
+		} else {
+			e := Entry[Int, int]{Interval: randInterval(), Value: k}
+			tr.Insert(e.Interval, e.Value)
+			entries = append(entries, e)
+		}
+
+		q := randInterval()
+		want := 0
+		for _, e := range entries {
+			if e.Interval.Overlaps(q) {
+				want++
+			}
+		}
+		if got := tr.Overlapping(q); len(got) != want {
+			t.Fatalf("Overlapping(%v): want %d entries, got %d", q, want, len(got))
+		}
+		assertEqual(t, len(entries), tr.Len())
+	}
+	if h, n := tr.root.getHeight(), tr.Len(); h > 2*bitLen(n)+1 {
+		t.Errorf("tree is not balanced: height %d for %d entries", h, n)
+	}
+}
+
+func bitLen(n int) int {
+	l := 0
+	for ; n > 0; n >>= 1 {
+		l++
+	}
+	return l
+}